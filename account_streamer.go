@@ -0,0 +1,277 @@
+package tastytrade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// Account streamer notification types carried in StreamerMessage.Type.
+const (
+	StreamerMessageOrder           = "Order"            // An order was created or changed
+	StreamerMessageComplexOrder    = "ComplexOrder"     // A complex order was created or changed
+	StreamerMessagePosition        = "CurrentPosition"  // A position was opened, changed or closed
+	StreamerMessageAccountBalance  = "AccountBalance"   // Account balances changed
+	StreamerMessageQuoteAlert      = "QuoteAlert"       // A quote alert was triggered
+	StreamerMessagePublicWatchlist = "PublicWatchlists" // A public watchlist changed
+)
+
+const (
+	// defaultHeartbeatInterval is how often the account streamer sends heartbeats; the server
+	// closes connections that stay silent for a minute.
+	defaultHeartbeatInterval = 30 * time.Second
+	// streamerChannelSize is the buffer size of the account streamer's channels.
+	streamerChannelSize = 64
+)
+
+// ErrStreamerClosed is returned by AccountStreamer methods after the connection was closed.
+var ErrStreamerClosed = errors.New("account streamer closed")
+
+// StreamerMessage is a notification received from the account streamer.
+// Messages whose type has no dedicated channel are delivered on AccountStreamer.Messages with the raw payload.
+type StreamerMessage struct {
+	Type      string          `json:"type"`      // Notification type (e.g., "Order", "AccountBalance")
+	Data      json.RawMessage `json:"data"`      // Raw notification payload
+	Timestamp int64           `json:"timestamp"` // Server timestamp (Unix milliseconds)
+}
+
+// streamerRequest is an action sent to the account streamer.
+type streamerRequest struct {
+	Action    string      `json:"action"`          // Action (e.g., "connect", "heartbeat")
+	Value     interface{} `json:"value,omitempty"` // Action argument (e.g., account numbers)
+	AuthToken string      `json:"auth-token"`      // Session token or OAuth2 bearer token
+	RequestID int64       `json:"request-id"`      // Identifier echoed in the response
+}
+
+// streamerResponse is the acknowledgement of an action or a notification, depending on which fields are set.
+type streamerResponse struct {
+	StreamerMessage
+	Status    string `json:"status"`     // "ok" or "error" for action responses
+	Action    string `json:"action"`     // Action the response belongs to
+	Message   string `json:"message"`    // Error message for failed actions
+	RequestID int64  `json:"request-id"` // Identifier of the request
+}
+
+// AccountStreamer receives real-time order, position and balance notifications for one or more accounts.
+// Create one with NewAccountStreamer, start it with Connect and read from its channels:
+//
+//	streamer := api.NewAccountStreamer("5WT00001")
+//	if err := streamer.Connect(ctx); err != nil {
+//		...
+//	}
+//	defer streamer.Close()
+//	for {
+//		select {
+//		case order := <-streamer.Orders:
+//			...
+//		case position := <-streamer.Positions:
+//			...
+//		case event := <-streamer.Events:
+//			if event.Type == tastytrade.SessionReconnected {
+//				// Notifications were missed; refresh with GetPositions and GetAccountBalances.
+//			}
+//		case <-streamer.Done():
+//			return streamer.Err()
+//		}
+//	}
+//
+// Dropped connections are re-established with a fresh session token and subscriptions are replayed.
+// Each channel must be drained; a full channel blocks delivery of every later notification.
+type AccountStreamer struct {
+	URL                  string        // Websocket URL (defaults to the client environment's AccountStreamerURL)
+	HeartbeatInterval    time.Duration // Interval between heartbeats (default: 30 seconds)
+	MaxReconnectAttempts int           // Reconnect attempts per outage before the streamer stops (0 retries forever)
+
+	Orders        chan Order           // Order notifications
+	ComplexOrders chan ComplexOrder    // Complex order notifications
+	Positions     chan Position        // Position notifications
+	Balances      chan BalanceData     // Balance notifications
+	Messages      chan StreamerMessage // Notifications of any other type (quote alerts, watchlists, ...)
+	Events        chan SessionEvent    // Connection events; SessionReconnected means notifications may have been missed
+
+	api            *TastytradeAPI
+	accountNumbers []string
+	session        *WebsocketSession
+	requestID      atomic.Int64
+}
+
+// NewAccountStreamer creates an account streamer for the given accounts.
+// The streamer authenticates with the client's current session (or OAuth2 token) when Connect is called.
+func (api *TastytradeAPI) NewAccountStreamer(accountNumbers ...string) *AccountStreamer {
+	session := api.NewWebsocketSession(api.environment.AccountStreamerURL)
+	s := &AccountStreamer{
+		URL:               session.URL,
+		HeartbeatInterval: defaultHeartbeatInterval,
+		Orders:            make(chan Order, streamerChannelSize),
+		ComplexOrders:     make(chan ComplexOrder, streamerChannelSize),
+		Positions:         make(chan Position, streamerChannelSize),
+		Balances:          make(chan BalanceData, streamerChannelSize),
+		Messages:          make(chan StreamerMessage, streamerChannelSize),
+		Events:            session.Events,
+		api:               api,
+		accountNumbers:    accountNumbers,
+		session:           session,
+	}
+	session.Handshake = s.handshake
+	session.Keepalive = func(ctx context.Context, conn *WebsocketConn) error {
+		return s.send(ctx, conn, "heartbeat", nil)
+	}
+	session.Handle = s.handle
+	return s
+}
+
+// Connect opens the websocket, subscribes to the streamer's accounts and starts the read and heartbeat loops.
+// ctx bounds the dial and the connect handshake only; use Close to stop the streamer.
+func (s *AccountStreamer) Connect(ctx context.Context) error {
+	s.session.URL = s.URL
+	s.session.KeepaliveInterval = s.HeartbeatInterval
+	// The server answers every heartbeat, so two silent intervals mean the connection is gone.
+	s.session.ReadTimeout = 2 * s.HeartbeatInterval
+	s.session.MaxReconnectAttempts = s.MaxReconnectAttempts
+
+	if err := s.session.Start(ctx); err != nil {
+		return fmt.Errorf("failed to connect to account streamer: %w", err)
+	}
+
+	s.api.log(ctx, slog.LevelInfo, "tastytrade account streamer connected", "url", s.URL, "accounts", s.accountNumbers)
+	return nil
+}
+
+// SubscribePublicWatchlists subscribes to changes of tastytrade's public watchlists.
+// Notifications are delivered on Messages with type "PublicWatchlists".
+func (s *AccountStreamer) SubscribePublicWatchlists(ctx context.Context) error {
+	return s.subscribe(ctx, "public-watchlists-subscribe")
+}
+
+// SubscribeQuoteAlerts subscribes to the user's quote alerts.
+// Notifications are delivered on Messages with type "QuoteAlert".
+func (s *AccountStreamer) SubscribeQuoteAlerts(ctx context.Context) error {
+	return s.subscribe(ctx, "quote-alerts-subscribe")
+}
+
+// Done returns a channel that is closed when the streamer stops, either through Close or because reconnecting failed.
+func (s *AccountStreamer) Done() <-chan struct{} {
+	return s.session.Done()
+}
+
+// Err returns the error that stopped the streamer, or nil if it is running or was closed with Close.
+func (s *AccountStreamer) Err() error {
+	return s.session.Err()
+}
+
+// Close closes the connection and stops the streamer.
+func (s *AccountStreamer) Close() error {
+	return s.session.Close()
+}
+
+// subscribe sends action and replays it after every reconnect.
+func (s *AccountStreamer) subscribe(ctx context.Context, action string) error {
+	select {
+	case <-s.session.Done():
+		return ErrStreamerClosed
+	default:
+	}
+
+	return s.session.Subscribe(ctx, action, func(ctx context.Context, conn *WebsocketConn) error {
+		return s.send(ctx, conn, action, nil)
+	})
+}
+
+// send writes an action with the current authorization to conn.
+func (s *AccountStreamer) send(ctx context.Context, conn *WebsocketConn, action string, value interface{}) error {
+	authorization, err := s.api.activeAuthenticator().Authorization(ctx)
+	if err != nil {
+		return fmt.Errorf("authorization failed: %w", err)
+	}
+
+	return conn.WriteJSON(streamerRequest{
+		Action:    action,
+		Value:     value,
+		AuthToken: authorization,
+		RequestID: s.requestID.Add(1),
+	})
+}
+
+// handshake subscribes to the streamer's accounts on a new connection and waits for the acknowledgement.
+func (s *AccountStreamer) handshake(ctx context.Context, conn *WebsocketConn) error {
+	if err := s.send(ctx, conn, "connect", s.accountNumbers); err != nil {
+		return err
+	}
+	for {
+		var response streamerResponse
+		if err := conn.ReadJSON(&response); err != nil {
+			return fmt.Errorf("account streamer connect failed: %w", err)
+		}
+		if response.Action != "connect" {
+			s.dispatch(response.StreamerMessage)
+			continue
+		}
+		if response.Status != "ok" {
+			return fmt.Errorf("account streamer connect failed: %s", response.Message)
+		}
+		return nil
+	}
+}
+
+// handle decodes an incoming message and dispatches notifications.
+func (s *AccountStreamer) handle(message []byte) error {
+	var response streamerResponse
+	if err := json.Unmarshal(message, &response); err != nil {
+		s.api.log(context.Background(), slog.LevelWarn, "tastytrade account streamer message decode failed", "error", err)
+		return nil
+	}
+	if response.Action != "" {
+		if response.Status == "error" {
+			s.api.log(context.Background(), slog.LevelWarn, "tastytrade account streamer action failed", "action", response.Action, "message", response.Message)
+		}
+		return nil
+	}
+	if response.Type != "" {
+		s.dispatch(response.StreamerMessage)
+	}
+	return nil
+}
+
+// dispatch decodes a notification and delivers it on the matching channel.
+func (s *AccountStreamer) dispatch(message StreamerMessage) {
+	var err error
+	switch message.Type {
+	case StreamerMessageOrder:
+		var order Order
+		if err = json.Unmarshal(message.Data, &order); err == nil {
+			deliver(s.session.Done(), s.Orders, order)
+		}
+	case StreamerMessageComplexOrder:
+		var complexOrder ComplexOrder
+		if err = json.Unmarshal(message.Data, &complexOrder); err == nil {
+			deliver(s.session.Done(), s.ComplexOrders, complexOrder)
+		}
+	case StreamerMessagePosition:
+		var raw positionRaw
+		if err = json.Unmarshal(message.Data, &raw); err == nil {
+			deliver(s.session.Done(), s.Positions, convertPositionRaw(raw))
+		}
+	case StreamerMessageAccountBalance:
+		var balance BalanceData
+		if err = json.Unmarshal(message.Data, &balance); err == nil {
+			deliver(s.session.Done(), s.Balances, balance)
+		}
+	default:
+		deliver(s.session.Done(), s.Messages, message)
+	}
+	if err != nil {
+		s.api.log(context.Background(), slog.LevelWarn, "tastytrade account streamer message decode failed", "type", message.Type, "error", err)
+	}
+}
+
+// deliver sends value on ch unless done is closed first.
+func deliver[T any](done <-chan struct{}, ch chan<- T, value T) {
+	select {
+	case ch <- value:
+	case <-done:
+	}
+}
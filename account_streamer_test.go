@@ -0,0 +1,202 @@
+package tastytrade
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// newStreamerServer starts a websocket server that acknowledges every action and, after the
+// connect action, sends the given notifications. Received actions are reported on the returned channel.
+func newStreamerServer(t *testing.T, notifications ...string) (*httptest.Server, <-chan streamerRequest) {
+	requests := make(chan streamerRequest, 16)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			t.Errorf("expected nil, got %v", err)
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var request streamerRequest
+			if err := conn.ReadJSON(&request); err != nil {
+				return
+			}
+			requests <- request
+			conn.WriteJSON(map[string]interface{}{"status": "ok", "action": request.Action, "request-id": request.RequestID})
+			if request.Action == "connect" {
+				for _, notification := range notifications {
+					conn.WriteMessage(websocket.TextMessage, []byte(notification))
+				}
+			}
+		}
+	}))
+	return server, requests
+}
+
+func TestAccountStreamer(t *testing.T) {
+	server, requests := newStreamerServer(t,
+		`{"type": "Order", "data": {"id": 1001, "status": "Filled", "legs": [{"symbol": "AAPL", "action": "Buy to Open", "quantity": 100}]}, "timestamp": 1726000000000}`,
+		`{"type": "CurrentPosition", "data": {"symbol": "AAPL", "quantity": 100, "multiplier": 1}, "timestamp": 1726000000001}`,
+		`{"type": "AccountBalance", "data": {"account-number": "123456", "cash-balance": "1000.5"}, "timestamp": 1726000000002}`,
+		`{"type": "QuoteAlert", "data": {"symbol": "AAPL"}, "timestamp": 1726000000003}`,
+	)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	api.authToken = "testtoken"
+	streamer := api.NewAccountStreamer("123456")
+	streamer.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	streamer.HeartbeatInterval = 20 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer streamer.Close()
+
+	connect := <-requests
+	if connect.Action != "connect" || connect.AuthToken != "testtoken" {
+		t.Errorf("unexpected connect request %+v", connect)
+	}
+
+	select {
+	case order := <-streamer.Orders:
+		if order.ID != 1001 || order.Status != "Filled" {
+			t.Errorf("unexpected order %+v", order)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for order")
+	}
+
+	select {
+	case position := <-streamer.Positions:
+		if position.Symbol != "AAPL" || position.Quantity.String() != "100" {
+			t.Errorf("unexpected position %+v", position)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for position")
+	}
+
+	select {
+	case balance := <-streamer.Balances:
+		if !balance.CashBalance.Equal(MustParseDecimal("1000.5")) {
+			t.Errorf("expected %s, got %s", "1000.5", balance.CashBalance)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for balance")
+	}
+
+	select {
+	case message := <-streamer.Messages:
+		if message.Type != StreamerMessageQuoteAlert {
+			t.Errorf("expected %s, got %s", StreamerMessageQuoteAlert, message.Type)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for quote alert")
+	}
+
+	if err := streamer.SubscribeQuoteAlerts(ctx); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	seen := map[string]bool{}
+	for !seen["heartbeat"] || !seen["quote-alerts-subscribe"] {
+		select {
+		case request := <-requests:
+			seen[request.Action] = true
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for actions, got %v", seen)
+		}
+	}
+}
+
+func TestAccountStreamerReconnects(t *testing.T) {
+	requests := make(chan streamerRequest, 16)
+	upgrader := websocket.Upgrader{}
+	var connections atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		first := connections.Add(1) == 1
+		for {
+			var request streamerRequest
+			if err := conn.ReadJSON(&request); err != nil {
+				return
+			}
+			requests <- request
+			conn.WriteJSON(map[string]interface{}{"status": "ok", "action": request.Action, "request-id": request.RequestID})
+			// Drop the first connection once the quote alert subscription arrived.
+			if first && request.Action == "quote-alerts-subscribe" {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	api.authToken = "testtoken"
+	streamer := api.NewAccountStreamer("123456")
+	streamer.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	streamer.session.MinReconnectDelay = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer streamer.Close()
+
+	if err := streamer.SubscribeQuoteAlerts(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	var reconnected bool
+	for !reconnected {
+		select {
+		case event := <-streamer.Events:
+			reconnected = event.Type == SessionReconnected
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for reconnect")
+		}
+	}
+
+	var actions []string
+	for len(actions) < 4 {
+		select {
+		case request := <-requests:
+			if request.AuthToken != "testtoken" {
+				t.Errorf("expected %s, got %s", "testtoken", request.AuthToken)
+			}
+			actions = append(actions, request.Action)
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for actions, got %v", actions)
+		}
+	}
+
+	expected := []string{"connect", "quote-alerts-subscribe", "connect", "quote-alerts-subscribe"}
+	if strings.Join(actions, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v, got %v", expected, actions)
+	}
+
+	streamer.Close()
+	if err := streamer.SubscribePublicWatchlists(context.Background()); err != ErrStreamerClosed {
+		t.Errorf("expected %v, got %v", ErrStreamerClosed, err)
+	}
+	if streamer.Err() != nil {
+		t.Errorf("expected nil, got %v", streamer.Err())
+	}
+}
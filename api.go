@@ -1,9 +1,12 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -13,50 +16,130 @@ const (
 
 // TastytradeAPI represents the Tastytrade API client
 type TastytradeAPI struct {
-	httpClient *http.Client
-	authToken  string
-	host       string
-	apiVersion string // API version for Accept-Version header (e.g., "20250715")
+	httpClient       *http.Client
+	authMu           sync.RWMutex // Guards the session fields below
+	authToken        string
+	rememberToken    string        // Remember token of the current session, if any
+	username         string        // Login of the current session
+	password         string        // Password kept for re-authentication (only with WithAutoReauthenticate)
+	sessionExpiresAt time.Time     // Expiry of the current session (zero if unknown)
+	reauthMu         sync.Mutex    // Serializes re-authentication attempts
+	autoReauth       bool          // Whether to log in again and retry once on 401
+	authenticator    Authenticator // Authenticator for requests (nil uses password/remember-token sessions)
+	sessionStore     SessionStore  // Store persisting sessions across runs (nil disables persistence)
+	host             string
+	environment      Environment  // Environment the client talks to
+	productionWrites bool         // Whether state-mutating requests are allowed in production
+	apiVersion       string       // API version for Accept-Version header (e.g., "20250715")
+	userAgent        string       // User-Agent header sent with every request
+	logger           *slog.Logger // Logger for retries and failed requests (nil disables logging)
+	retryPolicy      *RetryPolicy // Retry policy for failed requests (nil disables retries)
+	rateLimiter      *RateLimiter // Client-side rate limiter (nil disables throttling)
 }
 
 // NewTastytradeAPI creates a new instance of TastytradeAPI
+// talking to the production environment, or to hosts[0] when given.
+// For compatibility with code written before the production write guard, the client allows
+// state-mutating requests in production. New offers the full set of configuration options
+// and refuses production writes unless WithProductionWrites(true) is given.
 func NewTastytradeAPI(hosts ...string) *TastytradeAPI {
-	host := baseURL
 	if len(hosts) > 0 {
-		host = hosts[0]
-	}
-	return &TastytradeAPI{
-		httpClient: &http.Client{Timeout: 10 * time.Second},
-		host:       host,
+		return New(WithHost(hosts[0]), WithProductionWrites(true))
 	}
+	return New(WithProductionWrites(true))
 }
 
 // SetAPIVersion sets the API version for subsequent requests.
 // If version is empty, the header will not be sent (defaults to 20250714).
 // Example: api.SetAPIVersion("20250715")
+//
+// Deprecated: Use the WithAPIVersion option when creating the client with New.
 func (api *TastytradeAPI) SetAPIVersion(version string) {
 	api.apiVersion = version
 }
 
-// fetchData sends a GET request to the specified URL with authorization
-func (api *TastytradeAPI) fetchData(url string) (map[string]interface{}, error) {
-	req, err := http.NewRequest("GET", url, nil)
+// log writes a message to the configured logger, if any.
+func (api *TastytradeAPI) log(ctx context.Context, level slog.Level, msg string, args ...any) {
+	if api.logger != nil {
+		api.logger.Log(ctx, level, msg, args...)
+	}
+}
+
+// roundTrip performs a single attempt of req, waiting on the rate limiter first when one is set.
+func (api *TastytradeAPI) roundTrip(req *http.Request) (*http.Response, error) {
+	if api.userAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", api.userAgent)
+	}
+	if api.rateLimiter == nil {
+		return api.httpClient.Do(req)
+	}
+
+	group := endpointGroupForPath(req.URL.Path)
+	if err := api.rateLimiter.Wait(req.Context(), group); err != nil {
+		return nil, err
+	}
+	resp, err := api.httpClient.Do(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		api.rateLimiter.Throttle(group)
+	}
+	return resp, err
+}
+
+// do authorizes and sends req and returns the response if it has a successful status code.
+// Any other status is turned into an *APIError decoded from the response body.
+// When the API rejects the credentials with 401 and the authenticator can renew them
+// (OAuth2, or sessions with WithAutoReauthenticate), req is retried once with fresh credentials.
+func (api *TastytradeAPI) do(req *http.Request) (*http.Response, error) {
+	authenticator := api.activeAuthenticator()
+	if err := api.authorize(req, authenticator); err != nil {
+		return nil, err
+	}
+
+	resp, err := api.send(req)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Authorization", api.authToken)
+	if resp.StatusCode == http.StatusUnauthorized && api.canReauthenticate(req) {
+		resp.Body.Close()
+		if err := authenticator.Refresh(req.Context(), req.Header.Get("Authorization")); err != nil {
+			return nil, fmt.Errorf("re-authentication failed: %w", err)
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		if err := api.authorize(req, authenticator); err != nil {
+			return nil, err
+		}
+		resp, err = api.send(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		apiErr := newAPIError(req, resp)
+		api.log(req.Context(), slog.LevelDebug, "tastytrade request failed", "method", req.Method, "url", apiErr.URL, "status", resp.StatusCode, "request_id", apiErr.RequestID)
+		return nil, apiErr
+	}
+	return resp, nil
+}
 
-	resp, err := api.httpClient.Do(req)
+// fetchData sends a GET request to the specified URL with authorization
+func (api *TastytradeAPI) fetchData(ctx context.Context, url string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
-		return nil, fmt.Errorf("client error occurred: status code %d", resp.StatusCode)
-	} else if resp.StatusCode >= 500 {
-		return nil, fmt.Errorf("server error occurred: status code %d", resp.StatusCode)
+	resp, err := api.do(req)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	var data map[string]interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
@@ -67,25 +150,18 @@ func (api *TastytradeAPI) fetchData(url string) (map[string]interface{}, error)
 }
 
 // Helper function to fetch and unmarshal data
-func (api *TastytradeAPI) fetchDataAndUnmarshal(urlVal string, v interface{}) error {
-	req, err := http.NewRequest("GET", urlVal, nil)
+func (api *TastytradeAPI) fetchDataAndUnmarshal(ctx context.Context, urlVal string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", urlVal, nil)
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Authorization", api.authToken)
 
-	resp, err := api.httpClient.Do(req)
+	resp, err := api.do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
-		return fmt.Errorf("client error occurred: status code %d", resp.StatusCode)
-	} else if resp.StatusCode >= 500 {
-		return fmt.Errorf("server error occurred: status code %d", resp.StatusCode)
-	}
-
 	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
 		return err
 	}
@@ -95,28 +171,21 @@ func (api *TastytradeAPI) fetchDataAndUnmarshal(urlVal string, v interface{}) er
 
 // fetchInstrumentData sends a GET request to an instrument endpoint with authorization and Accept-Version header.
 // This is used specifically for instrument endpoints that support versioning.
-func (api *TastytradeAPI) fetchInstrumentData(url string) (map[string]interface{}, error) {
-	req, err := http.NewRequest("GET", url, nil)
+func (api *TastytradeAPI) fetchInstrumentData(ctx context.Context, url string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Authorization", api.authToken)
 	if api.apiVersion != "" {
 		req.Header.Set("Accept-Version", api.apiVersion)
 	}
 
-	resp, err := api.httpClient.Do(req)
+	resp, err := api.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
-		return nil, fmt.Errorf("client error occurred: status code %d", resp.StatusCode)
-	} else if resp.StatusCode >= 500 {
-		return nil, fmt.Errorf("server error occurred: status code %d", resp.StatusCode)
-	}
-
 	var data map[string]interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
 		return nil, err
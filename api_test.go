@@ -1,6 +1,8 @@
 package tastytrade
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -13,7 +15,7 @@ func TestFetchData(t *testing.T) {
 	defer server.Close()
 
 	api := NewTastytradeAPI(server.URL)
-	resp, err := api.fetchData(server.URL)
+	resp, err := api.fetchData(context.Background(), server.URL)
 
 	if err != nil {
 		t.Errorf("expected nil, got %v", err)
@@ -32,7 +34,7 @@ func TestFetchDataAndUnmarshal(t *testing.T) {
 
 	api := NewTastytradeAPI(server.URL)
 	var v map[string]interface{}
-	err := api.fetchDataAndUnmarshal(server.URL, &v)
+	err := api.fetchDataAndUnmarshal(context.Background(), server.URL, &v)
 
 	if err != nil {
 		t.Errorf("expected nil, got %v", err)
@@ -42,3 +44,20 @@ func TestFetchDataAndUnmarshal(t *testing.T) {
 		t.Errorf("expected %s, got %s", "test", v["data"])
 	}
 }
+
+func TestFetchDataCanceledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data": "test"}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	api := NewTastytradeAPI(server.URL)
+	_, err := api.GetPositionsCtx(ctx, "123456")
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,243 @@
+package tastytrade
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// errReauthDisabled is returned when a session expired and automatic re-authentication is not enabled.
+var errReauthDisabled = errors.New("automatic re-authentication is disabled")
+
+// Authenticator supplies credentials for API requests.
+// Implementations must be safe for concurrent use.
+type Authenticator interface {
+	// Authorization returns the value of the Authorization header for the next request.
+	Authorization(ctx context.Context) (string, error)
+	// Refresh renews the credentials after the API rejected the given Authorization value.
+	// Implementations should do nothing if the credentials were already renewed by another caller.
+	Refresh(ctx context.Context, rejected string) error
+}
+
+// activeAuthenticator returns the configured authenticator, falling back to password/remember-token sessions.
+func (api *TastytradeAPI) activeAuthenticator() Authenticator {
+	if api.authenticator != nil {
+		return api.authenticator
+	}
+	return sessionAuthenticator{api: api}
+}
+
+// authorize sets the Authorization header of req using the given authenticator.
+func (api *TastytradeAPI) authorize(req *http.Request, authenticator Authenticator) error {
+	authorization, err := authenticator.Authorization(req.Context())
+	if err != nil {
+		return fmt.Errorf("authorization failed: %w", err)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	return nil
+}
+
+// canReauthenticate reports whether a request rejected with 401 may be retried with renewed credentials.
+// Requests to the session endpoints themselves are never retried.
+func (api *TastytradeAPI) canReauthenticate(req *http.Request) bool {
+	if api.authenticator == nil && !api.autoReauth {
+		return false
+	}
+	if strings.HasPrefix(req.URL.Path, "/sessions") {
+		return false
+	}
+	return req.Body == nil || req.GetBody != nil
+}
+
+// sessionAuthenticator authenticates requests with the session token obtained by
+// Authenticate, AuthenticateWithRememberMe, LoginWithRememberToken or ResumeSession.
+type sessionAuthenticator struct {
+	api *TastytradeAPI
+}
+
+// Authorization returns the session token, logging in again first if the session is known
+// to have expired and automatic re-authentication is enabled.
+func (s sessionAuthenticator) Authorization(ctx context.Context) (string, error) {
+	s.api.authMu.RLock()
+	token := s.api.authToken
+	s.api.authMu.RUnlock()
+
+	if s.api.autoReauth && s.api.sessionExpired(time.Now()) {
+		if err := s.api.reauthenticate(ctx, token); err != nil {
+			return "", err
+		}
+		s.api.authMu.RLock()
+		token = s.api.authToken
+		s.api.authMu.RUnlock()
+	}
+	return token, nil
+}
+
+// Refresh logs in again with the remember token or stored password.
+func (s sessionAuthenticator) Refresh(ctx context.Context, rejected string) error {
+	if !s.api.autoReauth {
+		return errReauthDisabled
+	}
+	return s.api.reauthenticate(ctx, rejected)
+}
+
+const (
+	// defaultOAuth2RefreshBefore is how long before expiry an access token is proactively refreshed.
+	defaultOAuth2RefreshBefore = time.Minute
+	// defaultOAuth2TokenLifetime is assumed for access tokens issued without expires_in.
+	defaultOAuth2TokenLifetime = 15 * time.Minute
+)
+
+// OAuth2Token represents the response of the OAuth2 token endpoint.
+type OAuth2Token struct {
+	AccessToken  string `json:"access_token"`  // Short-lived access token sent as a Bearer token
+	TokenType    string `json:"token_type"`    // Token type (e.g., "Bearer")
+	ExpiresIn    int    `json:"expires_in"`    // Lifetime of the access token in seconds (15 minutes when missing)
+	IDToken      string `json:"id_token"`      // OpenID Connect ID token, when issued
+	RefreshToken string `json:"refresh_token"` // Replacement refresh token, when the server rotates the grant
+}
+
+// OAuth2Authenticator authenticates requests with OAuth2 access tokens obtained by exchanging
+// a refresh token and client secret at the /oauth/token endpoint. Access tokens are refreshed
+// proactively shortly before they expire. It is safe for concurrent use; concurrent callers
+// share a single refresh.
+type OAuth2Authenticator struct {
+	TokenURL      string        // URL of the token endpoint (e.g., "https://api.tastytrade.com/oauth/token")
+	ClientID      string        // OAuth2 client ID (optional)
+	ClientSecret  string        // OAuth2 client secret
+	RefreshToken  string        // Long-lived refresh token of the OAuth2 grant (replaced when the server rotates it)
+	Scopes        []string      // Requested scopes (optional)
+	HTTPClient    *http.Client  // HTTP client for token requests (defaults to http.DefaultClient)
+	RefreshBefore time.Duration // How long before expiry to refresh (defaults to one minute)
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+	now         func() time.Time
+}
+
+// NewOAuth2Authenticator creates an OAuth2Authenticator for the token endpoint at tokenURL.
+func NewOAuth2Authenticator(tokenURL, clientSecret, refreshToken string) *OAuth2Authenticator {
+	return &OAuth2Authenticator{
+		TokenURL:     tokenURL,
+		ClientSecret: clientSecret,
+		RefreshToken: refreshToken,
+	}
+}
+
+// Authorization returns "Bearer <access token>", fetching a new access token if the current one
+// is missing or about to expire.
+func (a *OAuth2Authenticator) Authorization(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	refreshBefore := a.RefreshBefore
+	if refreshBefore <= 0 {
+		refreshBefore = defaultOAuth2RefreshBefore
+	}
+	if a.accessToken == "" || !a.clock().Add(refreshBefore).Before(a.expiresAt) {
+		if err := a.refreshLocked(ctx); err != nil {
+			return "", err
+		}
+	}
+	return "Bearer " + a.accessToken, nil
+}
+
+// Refresh fetches a new access token unless the rejected one was already replaced.
+func (a *OAuth2Authenticator) Refresh(ctx context.Context, rejected string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.accessToken != "" && rejected != "Bearer "+a.accessToken {
+		return nil
+	}
+	return a.refreshLocked(ctx)
+}
+
+// ExpiresAt returns when the current access token expires (zero if no token was fetched yet).
+func (a *OAuth2Authenticator) ExpiresAt() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.expiresAt
+}
+
+// CurrentRefreshToken returns the refresh token used for the next refresh. It differs from the one the
+// authenticator was created with once the token endpoint has rotated the grant; persist it to log in again later.
+func (a *OAuth2Authenticator) CurrentRefreshToken() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.RefreshToken
+}
+
+func (a *OAuth2Authenticator) clock() time.Time {
+	if a.now != nil {
+		return a.now()
+	}
+	return time.Now()
+}
+
+// refreshLocked exchanges the refresh token for a new access token. a.mu must be held.
+func (a *OAuth2Authenticator) refreshLocked(ctx context.Context) error {
+	payload := map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": a.RefreshToken,
+		"client_secret": a.ClientSecret,
+	}
+	if a.ClientID != "" {
+		payload["client_id"] = a.ClientID
+	}
+	if len(a.Scopes) > 0 {
+		payload["scope"] = strings.Join(a.Scopes, " ")
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", a.TokenURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := a.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("oauth token request failed: %w", newAPIError(req, resp))
+	}
+
+	var token OAuth2Token
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return err
+	}
+	if token.AccessToken == "" {
+		return errors.New("oauth token response did not contain an access token")
+	}
+
+	lifetime := time.Duration(token.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultOAuth2TokenLifetime
+	}
+	a.accessToken = token.AccessToken
+	a.expiresAt = a.clock().Add(lifetime)
+	if token.RefreshToken != "" {
+		a.RefreshToken = token.RefreshToken
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package tastytrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestOAuth2Authenticator(t *testing.T) {
+	var mu sync.Mutex
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/oauth/token":
+			var body map[string]string
+			json.NewDecoder(req.Body).Decode(&body)
+			if body["grant_type"] != "refresh_token" || body["refresh_token"] != "refresh" || body["client_secret"] != "secret" {
+				t.Errorf("unexpected token request %v", body)
+			}
+			mu.Lock()
+			refreshes++
+			token := fmt.Sprintf("access%d", refreshes)
+			mu.Unlock()
+			rw.Write([]byte(fmt.Sprintf(`{"access_token": "%s", "token_type": "Bearer", "expires_in": 900}`, token)))
+		default:
+			if req.Header.Get("Authorization") != "Bearer access1" {
+				t.Errorf("expected %s, got %s", "Bearer access1", req.Header.Get("Authorization"))
+			}
+			rw.Write([]byte(`{"context": "test", "data": {"items": []}}`))
+		}
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithOAuth2("secret", "refresh"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := api.GetPositions("123456"); err != nil {
+				t.Errorf("expected nil, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if refreshes != 1 {
+		t.Errorf("expected %d, got %d", 1, refreshes)
+	}
+}
+
+func TestOAuth2AuthenticatorRefreshesBeforeExpiry(t *testing.T) {
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		refreshes++
+		rw.Write([]byte(fmt.Sprintf(`{"access_token": "access%d", "expires_in": 900}`, refreshes)))
+	}))
+	defer server.Close()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	auth := NewOAuth2Authenticator(server.URL, "secret", "refresh")
+	auth.now = func() time.Time { return now }
+
+	authorization, err := auth.Authorization(context.Background())
+	if err != nil || authorization != "Bearer access1" {
+		t.Errorf("expected %s, got %s (%v)", "Bearer access1", authorization, err)
+	}
+
+	now = now.Add(10 * time.Minute)
+	authorization, _ = auth.Authorization(context.Background())
+	if authorization != "Bearer access1" {
+		t.Errorf("expected %s, got %s", "Bearer access1", authorization)
+	}
+
+	now = now.Add(4*time.Minute + 30*time.Second)
+	authorization, _ = auth.Authorization(context.Background())
+	if authorization != "Bearer access2" {
+		t.Errorf("expected %s, got %s", "Bearer access2", authorization)
+	}
+}
+
+func TestOAuth2AuthenticatorRotatesRefreshToken(t *testing.T) {
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var body map[string]string
+		json.NewDecoder(req.Body).Decode(&body)
+		expected := "refresh"
+		if refreshes > 0 {
+			expected = fmt.Sprintf("refresh%d", refreshes)
+		}
+		if body["refresh_token"] != expected {
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"error": {"code": "invalid_grant", "message": "Refresh token is invalid"}}`))
+			return
+		}
+		refreshes++
+		// No expires_in: the authenticator must assume a lifetime instead of refreshing on every call.
+		rw.Write([]byte(fmt.Sprintf(`{"access_token": "access%d", "refresh_token": "refresh%d"}`, refreshes, refreshes)))
+	}))
+	defer server.Close()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	auth := NewOAuth2Authenticator(server.URL, "secret", "refresh")
+	auth.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if authorization, err := auth.Authorization(context.Background()); err != nil || authorization != "Bearer access1" {
+			t.Errorf("expected %s, got %s (%v)", "Bearer access1", authorization, err)
+		}
+	}
+
+	if !auth.ExpiresAt().Equal(now.Add(defaultOAuth2TokenLifetime)) {
+		t.Errorf("expected %v, got %v", now.Add(defaultOAuth2TokenLifetime), auth.ExpiresAt())
+	}
+
+	if err := auth.Refresh(context.Background(), "Bearer access1"); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if auth.CurrentRefreshToken() != "refresh2" {
+		t.Errorf("expected %s, got %s", "refresh2", auth.CurrentRefreshToken())
+	}
+
+	if refreshes != 2 {
+		t.Errorf("expected %d, got %d", 2, refreshes)
+	}
+}
+
+func TestOAuth2AuthenticatorRefreshesOnUnauthorized(t *testing.T) {
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/oauth/token":
+			refreshes++
+			rw.Write([]byte(fmt.Sprintf(`{"access_token": "access%d", "expires_in": 900}`, refreshes)))
+		default:
+			if req.Header.Get("Authorization") != "Bearer access2" {
+				rw.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			rw.Write([]byte(`{"context": "test", "data": {"account-number": "123456"}}`))
+		}
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithOAuth2("secret", "refresh"))
+	resp, err := api.GetAccountTradingStatus("123456")
+
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if resp.Data.AccountNumber != "123456" {
+		t.Errorf("expected %s, got %s", "123456", resp.Data.AccountNumber)
+	}
+
+	if refreshes != 2 {
+		t.Errorf("expected %d, got %d", 2, refreshes)
+	}
+}
+
+func TestOAuth2AuthenticatorTokenError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"error": {"code": "invalid_grant", "message": "Refresh token is invalid"}}`))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithOAuth2("secret", "revoked"))
+	_, err := api.GetPositions("123456")
+
+	apiErr, ok := asAPIError(err)
+	if !ok || apiErr.Code != "invalid_grant" {
+		t.Errorf("expected invalid_grant API error, got %v", err)
+	}
+}
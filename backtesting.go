@@ -2,8 +2,10 @@ package tastytrade
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 )
@@ -59,10 +61,10 @@ type BacktestResult struct {
 	TotalTrades       int     `json:"total-trades"`      // Total number of trades
 	WinningTrades     int     `json:"winning-trades"`    // Number of winning trades
 	LosingTrades      int     `json:"losing-trades"`     // Number of losing trades
-	TotalProfitLoss   float64 `json:"total-profit-loss"` // Total profit/loss
-	AverageProfitLoss float64 `json:"avg-profit-loss"`   // Average profit/loss per trade
-	MaxProfit         float64 `json:"max-profit"`        // Maximum profit
-	MaxLoss           float64 `json:"max-loss"`          // Maximum loss
+	TotalProfitLoss   Decimal `json:"total-profit-loss"` // Total profit/loss
+	AverageProfitLoss Decimal `json:"avg-profit-loss"`   // Average profit/loss per trade
+	MaxProfit         Decimal `json:"max-profit"`        // Maximum profit
+	MaxLoss           Decimal `json:"max-loss"`          // Maximum loss
 	WinRate           float64 `json:"win-rate"`          // Win rate percentage
 	CreatedAt         string  `json:"created-at"`        // Creation timestamp
 	Status            string  `json:"status"`            // Status: "completed", "running", "failed"
@@ -82,34 +84,71 @@ type BacktestsResponse struct {
 	Context string `json:"context"` // API context identifier
 }
 
-// postData sends a POST request with JSON body to the specified URL with authorization
-func (api *TastytradeAPI) postData(urlVal string, payload interface{}) (map[string]interface{}, error) {
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
+// postData sends a POST request with JSON body to the specified URL with authorization.
+// The request is refused in production unless writes were enabled with WithProductionWrites.
+func (api *TastytradeAPI) postData(ctx context.Context, urlVal string, payload interface{}) (map[string]interface{}, error) {
+	if err := api.checkWriteAllowed(); err != nil {
 		return nil, err
 	}
+	return api.sendJSON(ctx, "POST", urlVal, payload)
+}
 
-	req, err := http.NewRequest("POST", urlVal, bytes.NewBuffer(jsonData))
-	if err != nil {
+// putData sends a PUT request with JSON body to the specified URL with authorization.
+// The request is refused in production unless writes were enabled with WithProductionWrites.
+func (api *TastytradeAPI) putData(ctx context.Context, urlVal string, payload interface{}) (map[string]interface{}, error) {
+	if err := api.checkWriteAllowed(); err != nil {
 		return nil, err
 	}
-	req.Header.Set("Authorization", api.authToken)
-	req.Header.Set("Content-Type", "application/json")
+	return api.sendJSON(ctx, "PUT", urlVal, payload)
+}
 
-	resp, err := api.httpClient.Do(req)
+// patchData sends a PATCH request with JSON body to the specified URL with authorization.
+// The request is refused in production unless writes were enabled with WithProductionWrites.
+func (api *TastytradeAPI) patchData(ctx context.Context, urlVal string, payload interface{}) (map[string]interface{}, error) {
+	if err := api.checkWriteAllowed(); err != nil {
+		return nil, err
+	}
+	return api.sendJSON(ctx, "PATCH", urlVal, payload)
+}
+
+// deleteData sends a DELETE request to the specified URL with authorization.
+// The request is refused in production unless writes were enabled with WithProductionWrites.
+func (api *TastytradeAPI) deleteData(ctx context.Context, urlVal string) (map[string]interface{}, error) {
+	if err := api.checkWriteAllowed(); err != nil {
+		return nil, err
+	}
+	return api.sendJSON(ctx, "DELETE", urlVal, nil)
+}
+
+// sendJSON sends a request with JSON body to the specified URL with authorization and decodes the JSON response.
+// A nil payload sends no body, and an empty response decodes to an empty map.
+// Unlike postData it does not apply the production write guard; callers are responsible for that.
+func (api *TastytradeAPI) sendJSON(ctx context.Context, method, urlVal string, payload interface{}) (map[string]interface{}, error) {
+	var body io.Reader
+	if payload != nil {
+		jsonData, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, urlVal, body)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
-	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
-		return nil, fmt.Errorf("client error occurred: status code %d", resp.StatusCode)
-	} else if resp.StatusCode >= 500 {
-		return nil, fmt.Errorf("server error occurred: status code %d", resp.StatusCode)
+	resp, err := api.do(req)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	var data map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+	data := map[string]interface{}{}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil && err != io.EOF {
 		return nil, err
 	}
 
@@ -119,9 +158,14 @@ func (api *TastytradeAPI) postData(urlVal string, payload interface{}) (map[stri
 // SubmitBacktest submits a backtest request to the API.
 // Returns a BacktestResponse containing the backtest result or status.
 func (api *TastytradeAPI) SubmitBacktest(request BacktestRequest) (BacktestResponse, error) {
+	return api.SubmitBacktestCtx(context.Background(), request)
+}
+
+// SubmitBacktestCtx is like SubmitBacktest but carries ctx on the outgoing request.
+func (api *TastytradeAPI) SubmitBacktestCtx(ctx context.Context, request BacktestRequest) (BacktestResponse, error) {
 	urlVal := fmt.Sprintf("%s/backtesting", api.host)
 
-	data, err := api.postData(urlVal, request)
+	data, err := api.postData(ctx, urlVal, request)
 	if err != nil {
 		return BacktestResponse{}, err
 	}
@@ -143,9 +187,14 @@ func (api *TastytradeAPI) SubmitBacktest(request BacktestRequest) (BacktestRespo
 // GetBacktest retrieves a specific backtest by ID.
 // Returns a BacktestResponse containing the backtest result.
 func (api *TastytradeAPI) GetBacktest(backtestID string) (BacktestResponse, error) {
+	return api.GetBacktestCtx(context.Background(), backtestID)
+}
+
+// GetBacktestCtx is like GetBacktest but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetBacktestCtx(ctx context.Context, backtestID string) (BacktestResponse, error) {
 	urlVal := fmt.Sprintf("%s/backtesting/%s", api.host, url.PathEscape(backtestID))
 
-	data, err := api.fetchData(urlVal)
+	data, err := api.fetchData(ctx, urlVal)
 	if err != nil {
 		return BacktestResponse{}, err
 	}
@@ -167,9 +216,14 @@ func (api *TastytradeAPI) GetBacktest(backtestID string) (BacktestResponse, erro
 // ListBacktests retrieves a list of all backtests for the authenticated account.
 // Returns a BacktestsResponse containing an array of backtest results.
 func (api *TastytradeAPI) ListBacktests() (BacktestsResponse, error) {
+	return api.ListBacktestsCtx(context.Background())
+}
+
+// ListBacktestsCtx is like ListBacktests but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListBacktestsCtx(ctx context.Context) (BacktestsResponse, error) {
 	urlVal := fmt.Sprintf("%s/backtesting", api.host)
 
-	data, err := api.fetchData(urlVal)
+	data, err := api.fetchData(ctx, urlVal)
 	if err != nil {
 		return BacktestsResponse{}, err
 	}
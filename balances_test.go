@@ -33,8 +33,8 @@ func TestGetAccountBalances(t *testing.T) {
 		t.Errorf("expected %s, got %s", "123", resp.Data.AccountNumber)
 	}
 
-	if resp.Data.CashBalance != 1000 {
-		t.Errorf("expected %f, got %f", 1000.0, resp.Data.CashBalance)
+	if !resp.Data.CashBalance.Equal(NewDecimal(1000, 0)) {
+		t.Errorf("expected %s, got %s", "1000", resp.Data.CashBalance)
 	}
 
 	if resp.Context != "test" {
@@ -0,0 +1,190 @@
+package tastytrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+)
+
+// DXLink event flags relevant to candle snapshots.
+const (
+	candleFlagTxPending     = 0x01 // More events of the same transaction follow
+	candleFlagRemoveEvent   = 0x02 // The candle was removed
+	candleFlagSnapshotBegin = 0x04 // First event of a snapshot
+	candleFlagSnapshotEnd   = 0x08 // Last event of a snapshot
+	candleFlagSnapshotSnip  = 0x10 // Last event of a snapshot that was cut short by the server
+)
+
+// Candle is an OHLCV bar received through a DXLink Candle subscription. Values are NaN when unknown.
+type Candle struct {
+	Symbol        string    // Candle symbol (e.g., "SPY{=5m}")
+	Time          time.Time // Start of the bar
+	Open          float64   // Opening price
+	High          float64   // High price
+	Low           float64   // Low price
+	Close         float64   // Closing (or last) price
+	Volume        float64   // Volume traded during the bar
+	VWAP          float64   // Volume-weighted average price
+	ImpVolatility float64   // Implied volatility (options)
+	OpenInterest  float64   // Open interest (options and futures)
+}
+
+// candleSnapshot collects the candles of a snapshot until the server marks its end.
+type candleSnapshot struct {
+	candles map[int64]Candle // Candles by bar start (Unix milliseconds)
+	done    chan struct{}    // Closed when the snapshot is complete
+}
+
+// CandleSymbol returns the DXLink candle symbol for a streamer symbol and bar period,
+// e.g. CandleSymbol("SPY", "5m") returns "SPY{=5m}". Periods are a count followed by a unit:
+// "s" (seconds), "m" (minutes), "h" (hours), "d" (days), "w" (weeks), "mo" (months) or "y" (years).
+func CandleSymbol(symbol, period string) string {
+	return fmt.Sprintf("%s{=%s}", symbol, period)
+}
+
+// GetCandles retrieves OHLCV bars for a streamer symbol (e.g., "SPY", ".SPY241220C500") over DXLink.
+// It opens a market data streamer, backfills bars of the given period (e.g., "5m", "1d") starting at fromTime
+// and closes the streamer once the snapshot is complete.
+// Returns the candles sorted from oldest to newest. Use DXLinkStreamer.GetCandles to keep receiving live updates.
+func (api *TastytradeAPI) GetCandles(symbol, period string, fromTime time.Time) ([]Candle, error) {
+	return api.GetCandlesCtx(context.Background(), symbol, period, fromTime)
+}
+
+// GetCandlesCtx is like GetCandles but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetCandlesCtx(ctx context.Context, symbol, period string, fromTime time.Time) ([]Candle, error) {
+	streamer := api.NewDXLinkStreamer()
+	if err := streamer.Connect(ctx); err != nil {
+		return nil, err
+	}
+	defer streamer.Close()
+
+	return streamer.GetCandles(ctx, symbol, period, fromTime)
+}
+
+// GetCandles subscribes to bars of the given period for a streamer symbol starting at fromTime and waits for
+// the backfill snapshot to complete. Returns the candles sorted from oldest to newest.
+// The subscription is removed when GetCandles returns, unless it was already active. To keep receiving
+// live bars, Subscribe with EventTypeCandle and CandleSymbol(symbol, period) and read from Candles.
+func (s *DXLinkStreamer) GetCandles(ctx context.Context, symbol, period string, fromTime time.Time) ([]Candle, error) {
+	candleSymbol := CandleSymbol(symbol, period)
+	snapshot := &candleSnapshot{candles: make(map[int64]Candle), done: make(chan struct{})}
+
+	s.candlesMu.Lock()
+	if _, pending := s.snapshots[candleSymbol]; pending {
+		s.candlesMu.Unlock()
+		return nil, fmt.Errorf("candle snapshot for %s already in progress", candleSymbol)
+	}
+	s.snapshots[candleSymbol] = snapshot
+	s.candlesMu.Unlock()
+
+	subscription := dxlinkSubscription{Type: EventTypeCandle, Symbol: candleSymbol, FromTime: fromTime.UnixMilli()}
+	active := s.subscribed(EventTypeCandle, candleSymbol)
+	defer func() {
+		s.candlesMu.Lock()
+		if s.snapshots[candleSymbol] == snapshot {
+			delete(s.snapshots, candleSymbol)
+		}
+		s.candlesMu.Unlock()
+
+		if active {
+			// Keep the caller's subscription but don't replay this backfill after a reconnect.
+			s.subscriptionsMu.Lock()
+			delete(s.subscriptions, subscription)
+			s.subscriptionsMu.Unlock()
+			return
+		}
+		if err := s.Unsubscribe(context.Background(), EventTypeCandle, candleSymbol); err != nil && !errors.Is(err, ErrDXLinkClosed) {
+			s.api.log(ctx, slog.LevelWarn, "tastytrade dxlink candle unsubscribe failed", "symbol", candleSymbol, "error", err)
+		}
+	}()
+
+	if err := s.subscribe(ctx, []dxlinkSubscription{subscription}); err != nil {
+		return nil, err
+	}
+	select {
+	case <-snapshot.done:
+		return snapshot.sorted(), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-s.Done():
+		return nil, ErrDXLinkClosed
+	}
+}
+
+// handleCandle adds a candle to its pending snapshot, or delivers it on Candles when a Subscribe call
+// asked for the symbol. Candles is never waited on: updates are dropped when it is full, so a slow
+// reader cannot hold up the other event types.
+func (s *DXLinkStreamer) handleCandle(event map[string]interface{}) {
+	candle := newCandle(event)
+	flags := 0
+	if value := dxlinkFloat(event["eventFlags"]); !math.IsNaN(value) {
+		flags = int(value)
+	}
+
+	s.candlesMu.Lock()
+	snapshot, pending := s.snapshots[candle.Symbol]
+	if pending {
+		snapshot.add(candle, flags)
+		if flags&(candleFlagSnapshotEnd|candleFlagSnapshotSnip) != 0 && flags&candleFlagTxPending == 0 {
+			delete(s.snapshots, candle.Symbol)
+			close(snapshot.done)
+		}
+	}
+	s.candlesMu.Unlock()
+
+	if pending || flags&candleFlagRemoveEvent != 0 || !s.subscribed(EventTypeCandle, candle.Symbol) {
+		return
+	}
+	select {
+	case s.Candles <- candle:
+	default:
+		s.api.log(context.Background(), slog.LevelWarn, "tastytrade dxlink candle dropped, Candles channel is full", "symbol", candle.Symbol)
+	}
+}
+
+// add records candle in the snapshot, or drops the bar when the server removed it.
+// A new snapshot (e.g., after a reconnect) discards what was collected so far.
+func (c *candleSnapshot) add(candle Candle, flags int) {
+	if flags&candleFlagSnapshotBegin != 0 {
+		clear(c.candles)
+	}
+	key := candle.Time.UnixMilli()
+	if flags&candleFlagRemoveEvent != 0 {
+		delete(c.candles, key)
+		return
+	}
+	c.candles[key] = candle
+}
+
+// sorted returns the snapshot's candles from oldest to newest.
+func (c *candleSnapshot) sorted() []Candle {
+	candles := make([]Candle, 0, len(c.candles))
+	for _, candle := range c.candles {
+		candles = append(candles, candle)
+	}
+	sort.Slice(candles, func(i, j int) bool { return candles[i].Time.Before(candles[j].Time) })
+	return candles
+}
+
+// newCandle converts decoded COMPACT fields to a Candle.
+func newCandle(fields map[string]interface{}) Candle {
+	candle := Candle{
+		Symbol:        dxlinkString(fields["eventSymbol"]),
+		Open:          dxlinkFloat(fields["open"]),
+		High:          dxlinkFloat(fields["high"]),
+		Low:           dxlinkFloat(fields["low"]),
+		Close:         dxlinkFloat(fields["close"]),
+		Volume:        dxlinkFloat(fields["volume"]),
+		VWAP:          dxlinkFloat(fields["vwap"]),
+		ImpVolatility: dxlinkFloat(fields["impVolatility"]),
+		OpenInterest:  dxlinkFloat(fields["openInterest"]),
+	}
+	if millis := dxlinkFloat(fields["time"]); !math.IsNaN(millis) {
+		candle.Time = time.UnixMilli(int64(millis)).UTC()
+	}
+	return candle
+}
@@ -0,0 +1,175 @@
+package tastytrade
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// candleFeedData is a snapshot of three 5 minute SPY bars sent newest first, followed by a live update.
+const candleFeedData = `["Candle", [
+	"Candle", "SPY{=5m}", 4, 1726000600000, 562.0, 563.0, 561.5, 562.5, 12000, 562.3, "NaN", "NaN",
+	"Candle", "SPY{=5m}", 0, 1726000300000, 561.0, 562.2, 560.8, 562.0, 15000, 561.6, "NaN", "NaN",
+	"Candle", "SPY{=5m}", 8, 1726000000000, 560.0, 561.1, 559.9, 561.0, 18000, 560.5, "NaN", "NaN",
+	"Candle", "SPY{=5m}", 0, 1726000600000, 562.0, 563.4, 561.5, 563.2, 12500, 562.4, "NaN", "NaN"]]`
+
+func TestGetCandles(t *testing.T) {
+	server := newDXLinkServer(t, candleFeedData)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	candles, err := api.GetCandlesCtx(ctx, "SPY", "5m", time.UnixMilli(1726000000000))
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if len(candles) != 3 {
+		t.Fatalf("expected %d, got %d", 3, len(candles))
+	}
+
+	if candles[0].Time.UnixMilli() != 1726000000000 || candles[2].Time.UnixMilli() != 1726000600000 {
+		t.Errorf("expected candles sorted oldest first, got %v and %v", candles[0].Time, candles[2].Time)
+	}
+
+	if candles[0].Symbol != "SPY{=5m}" || candles[0].Open != 560.0 || candles[0].Volume != 18000 {
+		t.Errorf("unexpected candle %+v", candles[0])
+	}
+
+	if !math.IsNaN(candles[0].ImpVolatility) {
+		t.Errorf("expected NaN, got %f", candles[0].ImpVolatility)
+	}
+}
+
+func TestDXLinkStreamerGetCandlesUnsubscribes(t *testing.T) {
+	server := newDXLinkServer(t, candleFeedData)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	streamer := api.NewDXLinkStreamer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer streamer.Close()
+
+	candles, err := streamer.GetCandles(ctx, "SPY", "5m", time.UnixMilli(1726000000000))
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if len(candles) != 3 {
+		t.Fatalf("expected %d, got %d", 3, len(candles))
+	}
+
+	if streamer.subscribed(EventTypeCandle, "SPY{=5m}") {
+		t.Errorf("expected candle subscription to be removed")
+	}
+}
+
+func TestDXLinkStreamerGetCandlesCancelled(t *testing.T) {
+	// The server never completes the snapshot.
+	server := newDXLinkServer(t, `[]`)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	streamer := api.NewDXLinkStreamer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer streamer.Close()
+
+	candlesCtx, cancelCandles := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancelCandles()
+	if _, err := streamer.GetCandles(candlesCtx, "SPY", "5m", time.UnixMilli(1726000000000)); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	if streamer.subscribed(EventTypeCandle, "SPY{=5m}") {
+		t.Errorf("expected candle subscription to be removed")
+	}
+}
+
+func TestDXLinkStreamerCandleUpdates(t *testing.T) {
+	server := newDXLinkServer(t, candleFeedData)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	streamer := api.NewDXLinkStreamer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer streamer.Close()
+
+	if err := streamer.Subscribe(ctx, EventTypeCandle, CandleSymbol("SPY", "5m")); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	var candles []Candle
+	for len(candles) < 4 {
+		select {
+		case candle := <-streamer.Candles:
+			candles = append(candles, candle)
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for candles")
+		}
+	}
+
+	if candles[3].Close != 563.2 {
+		t.Errorf("expected %f, got %f", 563.2, candles[3].Close)
+	}
+}
+
+func TestDXLinkStreamerSlowCandleReader(t *testing.T) {
+	// Every subscription is answered with four candles and a quote. Candles is never read, yet quotes
+	// must keep flowing after it fills up.
+	server := newDXLinkServer(t, strings.Replace(candleFeedData, `"NaN", "NaN"]]`, `"NaN", "NaN"], "Quote", ["Quote", "AAPL", 220.1, 220.2, 100, 200]]`, 1))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	streamer := api.NewDXLinkStreamer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer streamer.Close()
+
+	const subscriptions = 2 * streamerChannelSize / 4
+	if err := streamer.Subscribe(ctx, EventTypeCandle, CandleSymbol("SPY", "5m")); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	for i := 1; i < subscriptions; i++ {
+		if err := streamer.Subscribe(ctx, EventTypeQuote, "AAPL"); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	}
+
+	for i := 0; i < subscriptions; i++ {
+		select {
+		case <-streamer.Quotes:
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for quote %d", i+1)
+		}
+	}
+}
+
+func TestCandleSymbol(t *testing.T) {
+	if symbol := CandleSymbol("SPY", "1d"); symbol != "SPY{=1d}" {
+		t.Errorf("expected %s, got %s", "SPY{=1d}", symbol)
+	}
+}
@@ -1,11 +1,12 @@
 package main
 
 import (
+	"bufio"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/optionsvamp/tastytrade"
@@ -38,16 +39,38 @@ func main() {
 	fmt.Println(strings.Repeat("=", 50))
 	fmt.Println()
 
-	// Initialize API client
-	api := tastytrade.NewTastytradeAPI()
+	// Initialize API client, persisting the session so later runs can skip the password login
+	opts := []tastytrade.Option{}
+	if sessionFile, err := tastytrade.DefaultSessionFile(tastytrade.Production); err == nil {
+		opts = append(opts, tastytrade.WithSessionStore(tastytrade.NewFileSessionStore(sessionFile)))
+	}
+	api := tastytrade.New(opts...)
 
-	// Authenticate
+	// Authenticate, reusing the saved session when it is still valid
 	fmt.Println("Authenticating...")
-	err := api.Authenticate(username, password)
+	resumed, err := api.ResumeSession()
 	if err != nil {
-		log.Fatalf("Authentication failed: %v", err)
+		log.Printf("Warning: Could not restore saved session: %v\n", err)
+	}
+	if resumed {
+		fmt.Println("✓ Authenticated (saved session)")
+	} else {
+		_, err := api.AuthenticateWithRememberMe(username, password)
+		var challenge *tastytrade.ChallengeError
+		if errors.As(err, &challenge) {
+			// Two-factor authentication is enabled: ask for the one-time password
+			fmt.Print("Enter the one-time password sent to your device: ")
+			otp, readErr := bufio.NewReader(os.Stdin).ReadString('\n')
+			if readErr != nil {
+				log.Fatalf("Failed to read one-time password: %v", readErr)
+			}
+			_, err = api.SubmitOTP(challenge, strings.TrimSpace(otp))
+		}
+		if err != nil {
+			log.Fatalf("Authentication failed: %v", err)
+		}
+		fmt.Println("✓ Authenticated")
 	}
-	fmt.Println("✓ Authenticated")
 	fmt.Println()
 
 	// List customer accounts to find the matching account
@@ -121,26 +144,6 @@ func main() {
 	fmt.Printf("✓ Fetched quotes for %d positions\n", len(quoteMap))
 	fmt.Println()
 
-	// Helper function to parse string to float64
-	parseFloat := func(s string) float64 {
-		if s == "" {
-			return 0
-		}
-		val, _ := strconv.ParseFloat(s, 64)
-		return val
-	}
-
-	// Helper function to parse quantity (handles both string and number formats)
-	parseQuantity := func(s string) float64 {
-		if s == "" {
-			return 0
-		}
-		// Remove any commas
-		s = strings.ReplaceAll(s, ",", "")
-		val, _ := strconv.ParseFloat(s, 64)
-		return val
-	}
-
 	// Calculate net delta and net theta
 	var netDelta, netTheta float64
 	type positionWithGreeks struct {
@@ -157,11 +160,19 @@ func main() {
 		
 		var delta, theta float64
 		if hasQuote {
-			delta = parseFloat(quote.Delta)
-			theta = parseFloat(quote.Theta)
+			parsed, err := quote.Parse()
+			if err != nil {
+				log.Printf("Warning: %v\n", err)
+			}
+			if parsed.Delta != nil {
+				delta = *parsed.Delta
+			}
+			if parsed.Theta != nil {
+				theta = *parsed.Theta
+			}
 		}
 
-		quantity := parseQuantity(pos.Quantity)
+		quantity := pos.Quantity.Float64()
 		multiplier := float64(pos.Multiplier)
 		
 		// Determine direction multiplier: +1 for Long, -1 for Short
@@ -235,7 +246,7 @@ func main() {
 			positionThetaStr,
 			pg.Position.AverageOpenPrice,
 			pg.Position.ClosePrice,
-			pg.Position.RealizedToday,
+			pg.Position.SignedRealizedToday(),
 		)
 	}
 
@@ -1,8 +1,9 @@
 package main
 
 import (
+	"bufio"
 	"encoding/csv"
-	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
@@ -73,16 +74,46 @@ func main() {
 	fmt.Println()
 
 	// Initialize API client
-	api := tastytrade.NewTastytradeAPI()
-	api.SetAPIVersion("20250715")
+	opts := []tastytrade.Option{
+		tastytrade.WithAPIVersion("20250715"),
+		tastytrade.WithRetryPolicy(tastytrade.DefaultRetryPolicy()),
+		tastytrade.WithAutoReauthenticate(),
+	}
+	// Persist the session so later runs can skip the password login
+	if sessionFile, err := tastytrade.DefaultSessionFile(tastytrade.Production); err == nil {
+		opts = append(opts, tastytrade.WithSessionStore(tastytrade.NewFileSessionStore(sessionFile)))
+	}
+	if *delayMs > 0 {
+		// Space out requests by delay-ms; the limiter also slows down automatically after a 429
+		opts = append(opts, tastytrade.WithRateLimiter(tastytrade.NewRateLimiter(1000/float64(*delayMs), 1)))
+	}
+	api := tastytrade.New(opts...)
 
-	// Authenticate
+	// Authenticate, reusing the saved session when it is still valid
 	fmt.Println("Authenticating...")
-	err := api.Authenticate(username, password)
+	resumed, err := api.ResumeSession()
 	if err != nil {
-		log.Fatalf("Authentication failed: %v", err)
+		log.Printf("Warning: Could not restore saved session: %v\n", err)
+	}
+	if resumed {
+		fmt.Println("✓ Authenticated (saved session)")
+	} else {
+		_, err := api.AuthenticateWithRememberMe(username, password)
+		var challenge *tastytrade.ChallengeError
+		if errors.As(err, &challenge) {
+			// Two-factor authentication is enabled: ask for the one-time password
+			fmt.Print("Enter the one-time password sent to your device: ")
+			otp, readErr := bufio.NewReader(os.Stdin).ReadString('\n')
+			if readErr != nil {
+				log.Fatalf("Failed to read one-time password: %v", readErr)
+			}
+			_, err = api.SubmitOTP(challenge, strings.TrimSpace(otp))
+		}
+		if err != nil {
+			log.Fatalf("Authentication failed: %v", err)
+		}
+		fmt.Println("✓ Authenticated")
 	}
-	fmt.Println("✓ Authenticated")
 	fmt.Println()
 
 	// Get option chain for the specified symbol
@@ -151,40 +182,23 @@ func main() {
 	fmt.Printf("  Will make approximately %d API requests for %d total symbols\n", expectedRequests, totalSymbols)
 
 	quoteMap := make(map[string]tastytrade.QuoteData)
-	delayDuration := time.Duration(*delayMs) * time.Millisecond
 
-	for i := 0; i < len(optionSymbols); i += *batchSize {
-		end := i + *batchSize
-		if end > len(optionSymbols) {
-			end = len(optionSymbols)
+	quotes, err := api.GetQuotes(&tastytrade.QuoteQueryParams{
+		EquityOption: optionSymbols,
+	}, &tastytrade.GetQuotesOptions{BatchSize: *batchSize})
+	var quotesErr *tastytrade.QuotesError
+	if errors.As(err, &quotesErr) {
+		for _, failure := range quotesErr.Failures {
+			log.Printf("Warning: Failed to fetch quotes for batch of %d symbols: %v\n", len(failure.Params.EquityOption), failure.Err)
 		}
-		batch := optionSymbols[i:end]
-
-		quotes, err := api.GetQuotesByType(&tastytrade.QuoteQueryParams{
-			EquityOption: batch,
-		})
-		if err != nil {
-			log.Printf("Warning: Failed to fetch quotes for batch %d-%d: %v\n", i, end, err)
-			// Still add delay even on error to respect rate limits
-			if i+*batchSize < len(optionSymbols) {
-				time.Sleep(delayDuration)
-			}
-			continue
-		}
-
-		for _, quote := range quotes.Data.Items {
-			quoteMap[quote.Symbol] = quote
-		}
-
-		requestsCompleted := (i + *batchSize) / *batchSize
-		fmt.Printf("  Fetched quotes for %d/%d options (%d requests completed)...\r", end, totalSymbols, requestsCompleted)
+	} else if err != nil {
+		log.Printf("Warning: Failed to fetch quotes: %v\n", err)
+	}
 
-		// Add delay between requests to avoid rate limiting (except after the last batch)
-		if i+*batchSize < len(optionSymbols) {
-			time.Sleep(delayDuration)
-		}
+	for _, quote := range quotes.Data.Items {
+		quoteMap[quote.Symbol] = quote
 	}
-	fmt.Printf("\n✓ Fetched quotes for %d options in %d API requests\n\n", len(quoteMap), expectedRequests)
+	fmt.Printf("✓ Fetched quotes for %d options in %d API requests\n\n", len(quoteMap), expectedRequests)
 
 	// Group options by expiration - include ALL available data
 	type OptionRow struct {
@@ -272,61 +286,29 @@ func main() {
 		// Get quote data
 		quote, hasQuote := quoteMap[option.Symbol]
 
-		// Parse Open Interest
-		var oi float64
-		if hasQuote && len(quote.OpenInterest) > 0 {
-			var oiValue interface{}
-			if err := json.Unmarshal(quote.OpenInterest, &oiValue); err == nil {
-				switch v := oiValue.(type) {
-				case float64:
-					oi = v
-				case int64:
-					oi = float64(v)
-				case string:
-					oi, _ = strconv.ParseFloat(v, 64)
-				}
+		// Parse quote values; malformed values are reported and left empty
+		parsed, err := quote.Parse()
+		if hasQuote && err != nil {
+			log.Printf("Warning: %v\n", err)
+		}
+		value := func(f *float64) float64 {
+			if f == nil {
+				return 0
 			}
+			return *f
 		}
-
-		// Helper function to parse string to float64
-		parseFloat := func(s string) float64 {
-			if s == "" {
+		price := func(d *tastytrade.Decimal) float64 {
+			if d == nil {
 				return 0
 			}
-			val, _ := strconv.ParseFloat(s, 64)
-			return val
+			return d.Float64()
 		}
-
-		// Parse all quote price fields
-		bid := parseFloat(quote.Bid)
-		bidSize := parseFloat(quote.BidSize)
-		ask := parseFloat(quote.Ask)
-		askSize := parseFloat(quote.AskSize)
-		mid := parseFloat(quote.Mid)
-		mark := parseFloat(quote.Mark)
-		last := parseFloat(quote.Last)
-		lastMkt := parseFloat(quote.LastMkt)
-		open := parseFloat(quote.Open)
-		close := parseFloat(quote.Close)
-		prevClose := parseFloat(quote.PrevClose)
-		dayHigh := parseFloat(quote.DayHighPrice)
-		dayLow := parseFloat(quote.DayLowPrice)
-		yearHigh := parseFloat(quote.YearHighPrice)
-		yearLow := parseFloat(quote.YearLowPrice)
-		volume := parseFloat(quote.Volume)
-
-		// Extract Greeks from quote data (they're now in the struct)
-		var delta, gamma, theta, vega, rho, iv, theoPrice, dxMark, tickSize float64
-		if hasQuote {
-			delta = parseFloat(quote.Delta)
-			gamma = parseFloat(quote.Gamma)
-			theta = parseFloat(quote.Theta)
-			vega = parseFloat(quote.Vega)
-			rho = parseFloat(quote.Rho)
-			iv = parseFloat(quote.Volatility) // Implied volatility is in "volatility" field
-			theoPrice = parseFloat(quote.TheoPrice)
-			dxMark = parseFloat(quote.DxMark)
-			tickSize = parseFloat(quote.TickSize)
+		var oi, volume float64
+		if parsed.OpenInterest != nil {
+			oi = float64(*parsed.OpenInterest)
+		}
+		if parsed.Volume != nil {
+			volume = float64(*parsed.Volume)
 		}
 
 		row := OptionRow{
@@ -354,21 +336,21 @@ func main() {
 			MarketTimeInstrumentCollection: option.MarketTimeInstrumentCollection,
 
 			// Quote prices
-			Bid:           bid,
-			BidSize:       bidSize,
-			Ask:           ask,
-			AskSize:       askSize,
-			Mid:           mid,
-			Mark:          mark,
-			Last:          last,
-			LastMkt:       lastMkt,
-			Open:          open,
-			Close:         close,
-			PrevClose:     prevClose,
-			DayHighPrice:  dayHigh,
-			DayLowPrice:   dayLow,
-			YearHighPrice: yearHigh,
-			YearLowPrice:  yearLow,
+			Bid:           price(parsed.Bid),
+			BidSize:       value(parsed.BidSize),
+			Ask:           price(parsed.Ask),
+			AskSize:       value(parsed.AskSize),
+			Mid:           price(parsed.Mid),
+			Mark:          price(parsed.Mark),
+			Last:          price(parsed.Last),
+			LastMkt:       price(parsed.LastMkt),
+			Open:          price(parsed.Open),
+			Close:         price(parsed.Close),
+			PrevClose:     price(parsed.PrevClose),
+			DayHighPrice:  price(parsed.DayHighPrice),
+			DayLowPrice:   price(parsed.DayLowPrice),
+			YearHighPrice: price(parsed.YearHighPrice),
+			YearLowPrice:  price(parsed.YearLowPrice),
 
 			// Volume and interest
 			OpenInterest: oi,
@@ -383,15 +365,15 @@ func main() {
 			HaltEndTime:     quote.HaltEndTime,
 
 			// Greeks
-			Delta:     delta,
-			Gamma:     gamma,
-			Theta:     theta,
-			Vega:      vega,
-			Rho:       rho,
-			IV:        iv,
-			TheoPrice: theoPrice,
-			DxMark:    dxMark,
-			TickSize:  tickSize,
+			Delta:     value(parsed.Delta),
+			Gamma:     value(parsed.Gamma),
+			Theta:     value(parsed.Theta),
+			Vega:      value(parsed.Vega),
+			Rho:       value(parsed.Rho),
+			IV:        value(parsed.Volatility), // Implied volatility is in "volatility" field
+			TheoPrice: price(parsed.TheoPrice),
+			DxMark:    price(parsed.DxMark),
+			TickSize:  price(parsed.TickSize),
 		}
 
 		expirationMap[expiration] = append(expirationMap[expiration], row)
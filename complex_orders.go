@@ -0,0 +1,268 @@
+package tastytrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Complex order types accepted in ComplexOrder.Type.
+const (
+	ComplexOrderTypeOTO   = "OTO"   // One triggers other: the contingent order is sent once the trigger order fills
+	ComplexOrderTypeOCO   = "OCO"   // One cancels other: two closing orders, the first to fill cancels the other
+	ComplexOrderTypeOTOCO = "OTOCO" // One triggers OCO: a trigger order followed by a profit target and a stop
+)
+
+// ErrInvalidComplexOrder is returned when a complex order fails client-side validation.
+// The returned error wraps it together with a description of the problem.
+var ErrInvalidComplexOrder = errors.New("invalid complex order")
+
+// closingActions maps each opening action to the action that closes it.
+var closingActions = map[string]string{
+	OrderActionBuyToOpen:  OrderActionSellToClose,
+	OrderActionSellToOpen: OrderActionBuyToClose,
+	OrderActionBuy:        OrderActionSell,
+	OrderActionSell:       OrderActionBuy,
+}
+
+// ComplexOrder represents a group of orders with contingencies between them, both as submitted
+// to PlaceComplexOrder/DryRunComplexOrder and as returned by the API.
+// Fields from ID onwards are read-only and ignored by the API when submitting.
+type ComplexOrder struct {
+	Type         string  `json:"type"`                    // Complex order type: "OTO", "OCO" or "OTOCO"
+	TriggerOrder *Order  `json:"trigger-order,omitempty"` // Opening order (OTO and OTOCO only)
+	Orders       []Order `json:"orders"`                  // Contingent orders: one for OTO, two for OCO and OTOCO
+
+	ID            int64   `json:"id,omitempty"`             // Complex order identifier
+	AccountNumber string  `json:"account-number,omitempty"` // Account the complex order belongs to
+	TerminalAt    string  `json:"terminal-at,omitempty"`    // Timestamp the complex order reached a terminal status
+	RelatedOrders []Order `json:"related-orders,omitempty"` // Orders related to the complex order
+}
+
+// ComplexOrderResult contains the complex order together with its expected buying power effect, fees, warnings and errors.
+type ComplexOrderResult struct {
+	ComplexOrder      ComplexOrder      `json:"complex-order"`       // The complex order as accepted (or simulated) by the API
+	BuyingPowerEffect BuyingPowerEffect `json:"buying-power-effect"` // Buying power effect of the complex order
+	FeeCalculation    FeeCalculation    `json:"fee-calculation"`     // Fees of the complex order
+	Warnings          []OrderMessage    `json:"warnings"`            // Warnings that do not prevent the complex order
+	Errors            []OrderMessage    `json:"errors"`              // Errors that would prevent the complex order (dry run only)
+}
+
+// ComplexOrderResponse represents the response structure returned by PlaceComplexOrder and DryRunComplexOrder.
+type ComplexOrderResponse struct {
+	Data    ComplexOrderResult `json:"data"`    // Complex order result data
+	Context string             `json:"context"` // API context identifier
+}
+
+// ComplexOrderDetailResponse represents the response structure returned by GetComplexOrder and CancelComplexOrder.
+type ComplexOrderDetailResponse struct {
+	Data    ComplexOrder `json:"data"`    // Complex order data
+	Context string       `json:"context"` // API context identifier
+}
+
+// Validate checks the structure of a complex order before it is sent.
+// OTO orders need a trigger order and one contingent order, OCO orders two contingent orders and no trigger,
+// and OTOCO orders a trigger order and two contingent orders. Contingent orders of OTO and OTOCO orders must
+// close legs of the trigger order (same symbol, opposite closing action), and each of them must close exactly
+// the quantity the trigger order opens for every symbol. OCO legs must use closing actions.
+// Returns an error wrapping ErrInvalidComplexOrder describing the first problem found.
+func (o ComplexOrder) Validate() error {
+	switch o.Type {
+	case ComplexOrderTypeOTO:
+		if o.TriggerOrder == nil {
+			return fmt.Errorf("%w: %s requires a trigger order", ErrInvalidComplexOrder, o.Type)
+		}
+		if len(o.Orders) != 1 {
+			return fmt.Errorf("%w: %s requires 1 contingent order, got %d", ErrInvalidComplexOrder, o.Type, len(o.Orders))
+		}
+	case ComplexOrderTypeOCO:
+		if o.TriggerOrder != nil {
+			return fmt.Errorf("%w: %s does not take a trigger order", ErrInvalidComplexOrder, o.Type)
+		}
+		if len(o.Orders) != 2 {
+			return fmt.Errorf("%w: %s requires 2 contingent orders, got %d", ErrInvalidComplexOrder, o.Type, len(o.Orders))
+		}
+	case ComplexOrderTypeOTOCO:
+		if o.TriggerOrder == nil {
+			return fmt.Errorf("%w: %s requires a trigger order", ErrInvalidComplexOrder, o.Type)
+		}
+		if len(o.Orders) != 2 {
+			return fmt.Errorf("%w: %s requires 2 contingent orders, got %d", ErrInvalidComplexOrder, o.Type, len(o.Orders))
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidComplexOrder, o.Type)
+	}
+
+	if o.TriggerOrder != nil && len(o.TriggerOrder.Legs) == 0 {
+		return fmt.Errorf("%w: trigger order has no legs", ErrInvalidComplexOrder)
+	}
+
+	for i, order := range o.Orders {
+		if len(order.Legs) == 0 {
+			return fmt.Errorf("%w: contingent order %d has no legs", ErrInvalidComplexOrder, i)
+		}
+		for _, leg := range order.Legs {
+			if err := validateClosingLeg(o.TriggerOrder, leg); err != nil {
+				return fmt.Errorf("%w: contingent order %d: %v", ErrInvalidComplexOrder, i, err)
+			}
+		}
+		if err := validateCoversTrigger(o.TriggerOrder, order); err != nil {
+			return fmt.Errorf("%w: contingent order %d: %v", ErrInvalidComplexOrder, i, err)
+		}
+	}
+	return nil
+}
+
+// validateCoversTrigger checks that order closes the full quantity of every leg of trigger, so that whichever
+// contingent order fills leaves no part of the position open and opens nothing new. Quantities are summed
+// per symbol, so a leg may be closed by several legs of order. It accepts any order when there is no trigger.
+func validateCoversTrigger(trigger *Order, order Order) error {
+	if trigger == nil {
+		return nil
+	}
+	opened := map[string]Decimal{}
+	for _, leg := range trigger.Legs {
+		opened[leg.Symbol] = opened[leg.Symbol].Add(leg.Quantity)
+	}
+	closed := map[string]Decimal{}
+	for _, leg := range order.Legs {
+		closed[leg.Symbol] = closed[leg.Symbol].Add(leg.Quantity)
+	}
+	for _, leg := range trigger.Legs {
+		quantity, ok := opened[leg.Symbol]
+		if !ok {
+			// Already reported for an earlier leg with the same symbol.
+			continue
+		}
+		delete(opened, leg.Symbol)
+		if !closed[leg.Symbol].Equal(quantity) {
+			return fmt.Errorf("trigger leg %s quantity %s is closed by quantity %s", leg.Symbol, quantity, closed[leg.Symbol])
+		}
+	}
+	return nil
+}
+
+// validateClosingLeg checks that leg closes a leg of trigger. Without a trigger order,
+// it only checks that the leg uses a closing action.
+func validateClosingLeg(trigger *Order, leg OrderLeg) error {
+	if trigger == nil {
+		switch leg.Action {
+		case OrderActionBuyToClose, OrderActionSellToClose, OrderActionBuy, OrderActionSell:
+			return nil
+		}
+		return fmt.Errorf("leg %s uses opening action %q", leg.Symbol, leg.Action)
+	}
+
+	for _, triggerLeg := range trigger.Legs {
+		if triggerLeg.Symbol != leg.Symbol {
+			continue
+		}
+		if closingActions[triggerLeg.Action] != leg.Action {
+			return fmt.Errorf("leg %s action %q does not close trigger action %q", leg.Symbol, leg.Action, triggerLeg.Action)
+		}
+		if leg.Quantity.Cmp(triggerLeg.Quantity) > 0 {
+			return fmt.Errorf("leg %s quantity %s exceeds trigger quantity %s", leg.Symbol, leg.Quantity, triggerLeg.Quantity)
+		}
+		return nil
+	}
+	return fmt.Errorf("leg %s is not part of the trigger order", leg.Symbol)
+}
+
+// PlaceComplexOrder validates and submits a complex order (OTO, OCO or OTOCO) for the specified account.
+// Returns a ComplexOrderResponse containing the accepted complex order, its buying power effect, fees and warnings.
+// In production this requires a client created with WithProductionWrites(true).
+func (api *TastytradeAPI) PlaceComplexOrder(accountNumber string, order ComplexOrder) (ComplexOrderResponse, error) {
+	return api.PlaceComplexOrderCtx(context.Background(), accountNumber, order)
+}
+
+// PlaceComplexOrderCtx is like PlaceComplexOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) PlaceComplexOrderCtx(ctx context.Context, accountNumber string, order ComplexOrder) (ComplexOrderResponse, error) {
+	if err := order.Validate(); err != nil {
+		return ComplexOrderResponse{}, err
+	}
+
+	urlVal := fmt.Sprintf("%s/accounts/%s/complex-orders", api.host, url.PathEscape(accountNumber))
+
+	data, err := api.postData(ctx, urlVal, order)
+	if err != nil {
+		return ComplexOrderResponse{}, err
+	}
+
+	var response ComplexOrderResponse
+	if err := decodeResponse(data, &response); err != nil {
+		return ComplexOrderResponse{}, err
+	}
+
+	return response, nil
+}
+
+// DryRunComplexOrder validates a complex order for the specified account without submitting it.
+// Returns a ComplexOrderResponse containing the simulated complex order, its buying power effect, fees, warnings and errors.
+// Dry runs do not change any state and are therefore allowed in production without WithProductionWrites.
+func (api *TastytradeAPI) DryRunComplexOrder(accountNumber string, order ComplexOrder) (ComplexOrderResponse, error) {
+	return api.DryRunComplexOrderCtx(context.Background(), accountNumber, order)
+}
+
+// DryRunComplexOrderCtx is like DryRunComplexOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) DryRunComplexOrderCtx(ctx context.Context, accountNumber string, order ComplexOrder) (ComplexOrderResponse, error) {
+	if err := order.Validate(); err != nil {
+		return ComplexOrderResponse{}, err
+	}
+
+	urlVal := fmt.Sprintf("%s/accounts/%s/complex-orders/dry-run", api.host, url.PathEscape(accountNumber))
+
+	data, err := api.sendJSON(ctx, "POST", urlVal, order)
+	if err != nil {
+		return ComplexOrderResponse{}, err
+	}
+
+	var response ComplexOrderResponse
+	if err := decodeResponse(data, &response); err != nil {
+		return ComplexOrderResponse{}, err
+	}
+
+	return response, nil
+}
+
+// GetComplexOrder retrieves a single complex order of the specified account by ID.
+// Returns a ComplexOrderDetailResponse containing the complex order and its orders.
+func (api *TastytradeAPI) GetComplexOrder(accountNumber string, complexOrderID int64) (ComplexOrderDetailResponse, error) {
+	return api.GetComplexOrderCtx(context.Background(), accountNumber, complexOrderID)
+}
+
+// GetComplexOrderCtx is like GetComplexOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetComplexOrderCtx(ctx context.Context, accountNumber string, complexOrderID int64) (ComplexOrderDetailResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/complex-orders/%d", api.host, url.PathEscape(accountNumber), complexOrderID)
+
+	var response ComplexOrderDetailResponse
+	if err := api.fetchDataAndUnmarshal(ctx, urlVal, &response); err != nil {
+		return ComplexOrderDetailResponse{}, err
+	}
+
+	return response, nil
+}
+
+// CancelComplexOrder requests cancellation of every working order in a complex order.
+// Returns a ComplexOrderDetailResponse containing the complex order.
+// In production this requires a client created with WithProductionWrites(true).
+func (api *TastytradeAPI) CancelComplexOrder(accountNumber string, complexOrderID int64) (ComplexOrderDetailResponse, error) {
+	return api.CancelComplexOrderCtx(context.Background(), accountNumber, complexOrderID)
+}
+
+// CancelComplexOrderCtx is like CancelComplexOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) CancelComplexOrderCtx(ctx context.Context, accountNumber string, complexOrderID int64) (ComplexOrderDetailResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/complex-orders/%d", api.host, url.PathEscape(accountNumber), complexOrderID)
+
+	data, err := api.deleteData(ctx, urlVal)
+	if err != nil {
+		return ComplexOrderDetailResponse{}, err
+	}
+
+	var response ComplexOrderDetailResponse
+	if err := decodeResponse(data, &response); err != nil {
+		return ComplexOrderDetailResponse{}, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,209 @@
+package tastytrade
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testOptionSymbol = "AAPL  240920C00220000"
+
+func testBracket() ComplexOrder {
+	closing := func(orderType string, price string) Order {
+		return Order{
+			TimeInForce: TimeInForceGTC,
+			OrderType:   orderType,
+			Price:       MustParseDecimal(price),
+			PriceEffect: EffectCredit,
+			Legs: []OrderLeg{
+				{InstrumentType: "Equity Option", Symbol: testOptionSymbol, Quantity: NewDecimal(1, 0), Action: OrderActionSellToClose},
+			},
+		}
+	}
+	trigger := testOrder()
+	stop := closing(OrderTypeStop, "0")
+	stop.StopTrigger = MustParseDecimal("0.5")
+	stop.PriceEffect = ""
+	return ComplexOrder{
+		Type:         ComplexOrderTypeOTOCO,
+		TriggerOrder: &trigger,
+		Orders:       []Order{closing(OrderTypeLimit, "2.10"), stop},
+	}
+}
+
+func TestComplexOrderValidate(t *testing.T) {
+	if err := testBracket().Validate(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	tests := map[string]func(o *ComplexOrder){
+		"unknown type":         func(o *ComplexOrder) { o.Type = "OTOCOCO" },
+		"missing trigger":      func(o *ComplexOrder) { o.TriggerOrder = nil },
+		"one contingent order": func(o *ComplexOrder) { o.Orders = o.Orders[:1] },
+		"wrong closing action": func(o *ComplexOrder) { o.Orders[0].Legs[0].Action = OrderActionBuyToClose },
+		"larger quantity":      func(o *ComplexOrder) { o.Orders[1].Legs[0].Quantity = NewDecimal(2, 0) },
+		"unrelated symbol":     func(o *ComplexOrder) { o.Orders[0].Legs[0].Symbol = "MSFT" },
+		"duplicate legs":       func(o *ComplexOrder) { o.Orders[0].Legs = append(o.Orders[0].Legs, o.Orders[0].Legs[0]) },
+		"oco with trigger":     func(o *ComplexOrder) { o.Type = ComplexOrderTypeOCO },
+		"oto with two orders":  func(o *ComplexOrder) { o.Type = ComplexOrderTypeOTO },
+	}
+	for name, mutate := range tests {
+		order := testBracket()
+		mutate(&order)
+		if err := order.Validate(); !errors.Is(err, ErrInvalidComplexOrder) {
+			t.Errorf("%s: expected %v, got %v", name, ErrInvalidComplexOrder, err)
+		}
+	}
+
+	oco := testBracket()
+	oco.Type = ComplexOrderTypeOCO
+	oco.TriggerOrder = nil
+	if err := oco.Validate(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	oco.Orders[0].Legs[0].Action = OrderActionSellToOpen
+	if err := oco.Validate(); !errors.Is(err, ErrInvalidComplexOrder) {
+		t.Errorf("expected %v, got %v", ErrInvalidComplexOrder, err)
+	}
+}
+
+func TestComplexOrderValidateCoversTrigger(t *testing.T) {
+	const putSymbol = "AAPL  240920P00200000"
+	order := testBracket()
+	order.TriggerOrder.Legs = append(order.TriggerOrder.Legs,
+		OrderLeg{InstrumentType: "Equity Option", Symbol: putSymbol, Quantity: NewDecimal(1, 0), Action: OrderActionBuyToOpen})
+
+	// The brackets only close the call leg, which would leave the put open.
+	if err := order.Validate(); !errors.Is(err, ErrInvalidComplexOrder) {
+		t.Errorf("expected %v, got %v", ErrInvalidComplexOrder, err)
+	}
+
+	for i := range order.Orders {
+		order.Orders[i].Legs = append(order.Orders[i].Legs,
+			OrderLeg{InstrumentType: "Equity Option", Symbol: putSymbol, Quantity: NewDecimal(1, 0), Action: OrderActionSellToClose})
+	}
+	if err := order.Validate(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	// A 5-lot trigger closed in two parts by each bracket is fully covered.
+	order = testBracket()
+	order.TriggerOrder.Legs[0].Quantity = NewDecimal(5, 0)
+	for i := range order.Orders {
+		order.Orders[i].Legs[0].Quantity = NewDecimal(3, 0)
+		order.Orders[i].Legs = append(order.Orders[i].Legs, order.Orders[i].Legs[0])
+		order.Orders[i].Legs[1].Quantity = NewDecimal(2, 0)
+	}
+	if err := order.Validate(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	// Two 3-lot legs close more than the 5-lot trigger opened.
+	order.Orders[1].Legs[1].Quantity = NewDecimal(3, 0)
+	if err := order.Validate(); !errors.Is(err, ErrInvalidComplexOrder) {
+		t.Errorf("expected %v, got %v", ErrInvalidComplexOrder, err)
+	}
+
+	// A 1-lot close of a 5-lot trigger leaves 4 open.
+	order.Orders[1].Legs = order.Orders[1].Legs[:1]
+	order.Orders[1].Legs[0].Quantity = NewDecimal(1, 0)
+	if err := order.Validate(); !errors.Is(err, ErrInvalidComplexOrder) {
+		t.Errorf("expected %v, got %v", ErrInvalidComplexOrder, err)
+	}
+}
+
+func TestPlaceComplexOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "POST" || req.URL.Path != "/accounts/123456/complex-orders" {
+			t.Errorf("got: %s %s, want: POST /accounts/123456/complex-orders", req.Method, req.URL.Path)
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		if body["type"] != "OTOCO" || body["trigger-order"] == nil {
+			t.Errorf("unexpected complex order body %v", body)
+		}
+		if orders, _ := body["orders"].([]interface{}); len(orders) != 2 {
+			t.Errorf("expected %d, got %d", 2, len(orders))
+		}
+
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(`{"context": "test", "data": {"complex-order": {"id": 77, "type": "OTOCO",
+			"trigger-order": {"id": 1001, "status": "Received", "legs": []},
+			"orders": [{"id": 1002, "status": "Contingent", "complex-order-tag": "OTOCO::oco-1-order", "legs": []}, {"id": 1003, "status": "Contingent", "legs": []}]},
+			"buying-power-effect": {"change-in-buying-power": "105.0"}, "fee-calculation": {"total-fees": "1.14"}, "warnings": []}}`))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	resp, err := api.PlaceComplexOrder("123456", testBracket())
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.ComplexOrder.ID != 77 {
+		t.Errorf("expected %d, got %d", 77, resp.Data.ComplexOrder.ID)
+	}
+
+	if resp.Data.ComplexOrder.TriggerOrder == nil || resp.Data.ComplexOrder.TriggerOrder.ID != 1001 {
+		t.Errorf("expected trigger order %d, got %v", 1001, resp.Data.ComplexOrder.TriggerOrder)
+	}
+
+	if len(resp.Data.ComplexOrder.Orders) != 2 {
+		t.Errorf("expected %d, got %d", 2, len(resp.Data.ComplexOrder.Orders))
+	}
+}
+
+func TestPlaceComplexOrderValidatesBeforeSending(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+	}))
+	defer server.Close()
+
+	order := testBracket()
+	order.Orders[0].Legs[0].Action = OrderActionBuyToOpen
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	if _, err := api.DryRunComplexOrder("123456", order); !errors.Is(err, ErrInvalidComplexOrder) {
+		t.Errorf("expected %v, got %v", ErrInvalidComplexOrder, err)
+	}
+}
+
+func TestGetAndCancelComplexOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/accounts/123456/complex-orders/77" {
+			t.Errorf("got: %s, want: /accounts/123456/complex-orders/77", req.URL.Path)
+		}
+		status := "Live"
+		if req.Method == "DELETE" {
+			status = "Cancel Requested"
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"id": 77, "type": "OCO", "orders": [{"id": 1002, "status": "` + status + `", "legs": []}]}}`))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	resp, err := api.GetComplexOrder("123456", 77)
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Type != "OCO" {
+		t.Errorf("expected %s, got %s", "OCO", resp.Data.Type)
+	}
+
+	resp, err = api.CancelComplexOrder("123456", 77)
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Orders[0].Status != "Cancel Requested" {
+		t.Errorf("expected %s, got %s", "Cancel Requested", resp.Data.Orders[0].Status)
+	}
+}
@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -50,8 +51,13 @@ type GetCryptocurrencyResult struct {
 // Returns a ListCryptocurrenciesResult containing all cryptocurrency instruments
 // with their trading characteristics and venue information.
 func (api *TastytradeAPI) ListCryptocurrencies() (ListCryptocurrenciesResult, error) {
+	return api.ListCryptocurrenciesCtx(context.Background())
+}
+
+// ListCryptocurrenciesCtx is like ListCryptocurrencies but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListCryptocurrenciesCtx(ctx context.Context) (ListCryptocurrenciesResult, error) {
 	url := fmt.Sprintf("%s/instruments/cryptocurrencies", api.host)
-	data, err := api.fetchInstrumentData(url)
+	data, err := api.fetchInstrumentData(ctx, url)
 	if err != nil {
 		return ListCryptocurrenciesResult{}, err
 	}
@@ -74,8 +80,13 @@ func (api *TastytradeAPI) ListCryptocurrencies() (ListCryptocurrenciesResult, er
 // Returns a GetCryptocurrencyResult containing detailed information about the cryptocurrency
 // including tick size, venue symbols, and trading status.
 func (api *TastytradeAPI) GetCryptocurrency(symbol string) (GetCryptocurrencyResult, error) {
+	return api.GetCryptocurrencyCtx(context.Background(), symbol)
+}
+
+// GetCryptocurrencyCtx is like GetCryptocurrency but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetCryptocurrencyCtx(ctx context.Context, symbol string) (GetCryptocurrencyResult, error) {
 	url := fmt.Sprintf("%s/instruments/cryptocurrencies/%s", api.host, symbol)
-	data, err := api.fetchInstrumentData(url)
+	data, err := api.fetchInstrumentData(ctx, url)
 	if err != nil {
 		return GetCryptocurrencyResult{}, err
 	}
@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -138,8 +139,13 @@ type AccountsResponse struct {
 // Returns a CustomerResponse containing personal information, addresses, suitability data,
 // and account preferences.
 func (api *TastytradeAPI) GetCustomerInfo() (CustomerResponse, error) {
+	return api.GetCustomerInfoCtx(context.Background())
+}
+
+// GetCustomerInfoCtx is like GetCustomerInfo but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetCustomerInfoCtx(ctx context.Context) (CustomerResponse, error) {
 	url := fmt.Sprintf("%s/customers/me", api.host)
-	data, err := api.fetchData(url)
+	data, err := api.fetchData(ctx, url)
 	if err != nil {
 		return CustomerResponse{}, err
 	}
@@ -162,8 +168,13 @@ func (api *TastytradeAPI) GetCustomerInfo() (CustomerResponse, error) {
 // Returns an AccountsResponse containing an array of account containers with account
 // information and authority levels.
 func (api *TastytradeAPI) ListCustomerAccounts() (AccountsResponse, error) {
+	return api.ListCustomerAccountsCtx(context.Background())
+}
+
+// ListCustomerAccountsCtx is like ListCustomerAccounts but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListCustomerAccountsCtx(ctx context.Context) (AccountsResponse, error) {
 	url := fmt.Sprintf("%s/customers/me/accounts", api.host)
-	data, err := api.fetchData(url)
+	data, err := api.fetchData(ctx, url)
 	if err != nil {
 		return AccountsResponse{}, err
 	}
@@ -186,8 +197,13 @@ func (api *TastytradeAPI) ListCustomerAccounts() (AccountsResponse, error) {
 // Returns an AccountResponse containing detailed account information including
 // account type, status, trading permissions, and configuration.
 func (api *TastytradeAPI) GetAccount(accountNumber string) (AccountResponse, error) {
+	return api.GetAccountCtx(context.Background(), accountNumber)
+}
+
+// GetAccountCtx is like GetAccount but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetAccountCtx(ctx context.Context, accountNumber string) (AccountResponse, error) {
 	url := fmt.Sprintf("%s/customers/me/accounts/%s", api.host, accountNumber)
-	data, err := api.fetchData(url)
+	data, err := api.fetchData(ctx, url)
 	if err != nil {
 		return AccountResponse{}, err
 	}
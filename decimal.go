@@ -0,0 +1,257 @@
+package tastytrade
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidDecimal is returned when a value cannot be parsed as a Decimal.
+var ErrInvalidDecimal = errors.New("invalid decimal")
+
+// maxDecimalExponent bounds the exponent accepted by ParseDecimal, which keeps "1e999999999" from
+// allocating a number with a billion digits.
+const maxDecimalExponent = 1000
+
+// Decimal is an exact fixed-point decimal number used for money, prices and quantities.
+// The zero value is 0. Decimals are immutable; arithmetic returns new values.
+//
+// Decimal unmarshals from JSON strings ("12.50", "1,000.00") and JSON numbers (12.5), and treats "" and null
+// as missing (0). "NaN" and other non-numeric strings are errors rather than a plausible-looking 0.
+// It marshals to a JSON string, the encoding the API uses for amounts.
+// Compare values with Cmp or Equal rather than ==, since equal values may differ in scale ("1.5" and "1.50").
+type Decimal struct {
+	coef  *big.Int // Unscaled value (nil means 0); never modified after construction
+	scale int32    // Number of digits after the decimal point
+}
+
+// NewDecimal returns the Decimal unscaled * 10^-scale, e.g. NewDecimal(1250, 2) is 12.50.
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	coef := big.NewInt(unscaled)
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	return newDecimal(coef, scale)
+}
+
+// NewDecimalFromFloat returns the Decimal with the shortest representation of f, e.g. 0.1 becomes "0.1".
+// NaN and infinities become 0.
+func NewDecimalFromFloat(f float64) Decimal {
+	d, err := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		return Decimal{}
+	}
+	return d
+}
+
+// ParseDecimal parses a decimal number such as "12", "-0.05", "+3.50" or "1.2e-3".
+// Returns an error wrapping ErrInvalidDecimal for anything else.
+func ParseDecimal(s string) (Decimal, error) {
+	text := strings.TrimSpace(s)
+	mantissa, exponent := text, int64(0)
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		exp, err := strconv.ParseInt(text[i+1:], 10, 32)
+		if err != nil || exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+		}
+		mantissa, exponent = text[:i], exp
+	}
+
+	negative := false
+	if mantissa != "" && (mantissa[0] == '-' || mantissa[0] == '+') {
+		negative = mantissa[0] == '-'
+		mantissa = mantissa[1:]
+	}
+	whole, fraction, _ := strings.Cut(mantissa, ".")
+	digits := whole + fraction
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+
+	coef, _ := new(big.Int).SetString(digits, 10)
+	if negative {
+		coef.Neg(coef)
+	}
+	scale := int64(len(fraction)) - exponent
+	if scale < 0 {
+		coef.Mul(coef, pow10(int32(-scale)))
+		scale = 0
+	}
+	return newDecimal(coef, int32(scale)), nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on invalid input. It is intended for constants and tests.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// String returns d in plain notation with all of its decimal places, e.g. "-12.50".
+func (d Decimal) String() string {
+	digits := d.int().String()
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		point := len(digits) - int(d.scale)
+		digits = digits[:point] + "." + digits[point:]
+	}
+	if negative {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Float64 returns the nearest float64 to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Add returns d + other.
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, scale := alignDecimals(d, other)
+	return newDecimal(new(big.Int).Add(a, b), scale)
+}
+
+// Sub returns d - other.
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, scale := alignDecimals(d, other)
+	return newDecimal(new(big.Int).Sub(a, b), scale)
+}
+
+// Mul returns d * other.
+func (d Decimal) Mul(other Decimal) Decimal {
+	return newDecimal(new(big.Int).Mul(d.int(), other.int()), d.scale+other.scale)
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return newDecimal(new(big.Int).Neg(d.int()), d.scale)
+}
+
+// Abs returns |d|.
+func (d Decimal) Abs() Decimal {
+	return newDecimal(new(big.Int).Abs(d.int()), d.scale)
+}
+
+// Round returns d rounded to the given number of decimal places, with halves rounded away from zero.
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if d.scale <= places {
+		return d
+	}
+	divisor := pow10(d.scale - places)
+	quotient, remainder := new(big.Int).QuoRem(d.int(), divisor, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(divisor) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(d.Sign())))
+	}
+	return newDecimal(quotient, places)
+}
+
+// roundToStep returns the multiple of step nearest to d, with halves rounded away from zero.
+// step must be positive.
+func (d Decimal) roundToStep(step Decimal) Decimal {
+	value, unit, _ := alignDecimals(d, step)
+	quotient, remainder := new(big.Int).QuoRem(value, unit, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(unit) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(d.Sign())))
+	}
+	return newDecimal(quotient.Mul(quotient, step.int()), step.scale)
+}
+
+// Cmp compares d and other and returns -1, 0 or +1.
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := alignDecimals(d, other)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and other have the same value, regardless of scale.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero reports whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// MarshalJSON encodes d as a JSON string.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes a JSON string or number. Thousands separators in strings are ignored;
+// empty strings and null decode to 0. Anything else that is not a number, including "NaN",
+// returns an error wrapping ErrInvalidDecimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		*d = Decimal{}
+		return nil
+	}
+	text := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		text = strings.ReplaceAll(strings.TrimSpace(text), ",", "")
+		if text == "" {
+			*d = Decimal{}
+			return nil
+		}
+	}
+	parsed, err := ParseDecimal(text)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// int returns the unscaled value of d.
+func (d Decimal) int() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// newDecimal returns the Decimal coef * 10^-scale.
+func newDecimal(coef *big.Int, scale int32) Decimal {
+	return Decimal{coef: coef, scale: scale}
+}
+
+// alignDecimals returns the unscaled values of a and b at their common scale.
+func alignDecimals(a, b Decimal) (*big.Int, *big.Int, int32) {
+	switch {
+	case a.scale < b.scale:
+		return new(big.Int).Mul(a.int(), pow10(b.scale-a.scale)), b.int(), b.scale
+	case a.scale > b.scale:
+		return a.int(), new(big.Int).Mul(b.int(), pow10(a.scale-b.scale)), a.scale
+	default:
+		return a.int(), b.int(), a.scale
+	}
+}
+
+// pow10 returns 10^n.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,144 @@
+package tastytrade
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := map[string]string{
+		"12":       "12",
+		"-0.05":    "-0.05",
+		"+3.50":    "3.50",
+		".5":       "0.5",
+		"1.2e-3":   "0.0012",
+		"1.5E2":    "150",
+		" 100.25 ": "100.25",
+	}
+	for input, expected := range tests {
+		d, err := ParseDecimal(input)
+		if err != nil {
+			t.Errorf("expected nil, got %v", err)
+			continue
+		}
+		if d.String() != expected {
+			t.Errorf("expected %s, got %s", expected, d.String())
+		}
+	}
+
+	for _, input := range []string{"", "-", ".", "1.2.3", "abc", "1e", "NaN", "1e99999"} {
+		if _, err := ParseDecimal(input); !errors.Is(err, ErrInvalidDecimal) {
+			t.Errorf("expected %v for %q, got %v", ErrInvalidDecimal, input, err)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.2")
+
+	if sum := a.Add(b); sum.String() != "0.3" {
+		t.Errorf("expected %s, got %s", "0.3", sum.String())
+	}
+
+	if diff := a.Sub(MustParseDecimal("1.25")); diff.String() != "-1.15" {
+		t.Errorf("expected %s, got %s", "-1.15", diff.String())
+	}
+
+	if product := MustParseDecimal("-1.5").Mul(MustParseDecimal("2.25")); product.String() != "-3.375" {
+		t.Errorf("expected %s, got %s", "-3.375", product.String())
+	}
+
+	if neg := a.Neg(); neg.String() != "-0.1" || neg.Abs().String() != "0.1" {
+		t.Errorf("expected %s, got %s", "-0.1", neg.String())
+	}
+
+	if !MustParseDecimal("1.50").Equal(MustParseDecimal("1.5")) {
+		t.Errorf("expected 1.50 to equal 1.5")
+	}
+
+	if MustParseDecimal("-2").Cmp(MustParseDecimal("1")) != -1 {
+		t.Errorf("expected -2 < 1")
+	}
+
+	var zero Decimal
+	if !zero.IsZero() || zero.String() != "0" || zero.Add(a).String() != "0.1" {
+		t.Errorf("expected zero value to be 0, got %s", zero.String())
+	}
+
+	if d := NewDecimal(1250, 2); d.String() != "12.50" {
+		t.Errorf("expected %s, got %s", "12.50", d.String())
+	}
+
+	if d := NewDecimalFromFloat(0.1); d.String() != "0.1" {
+		t.Errorf("expected %s, got %s", "0.1", d.String())
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		value    string
+		places   int32
+		expected string
+	}{
+		{"1.005", 2, "1.01"},
+		{"-1.005", 2, "-1.01"},
+		{"1.004", 2, "1.00"},
+		{"2.5", 0, "3"},
+		{"1.5", 3, "1.5"},
+	}
+	for _, test := range tests {
+		if rounded := MustParseDecimal(test.value).Round(test.places); rounded.String() != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, rounded.String())
+		}
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var values struct {
+		String Decimal `json:"string"`
+		Number Decimal `json:"number"`
+		Empty  Decimal `json:"empty"`
+		Null   Decimal `json:"null"`
+	}
+	err := json.Unmarshal([]byte(`{"string": "1000.50", "number": -0.25, "empty": "", "null": null}`), &values)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if values.String.String() != "1000.50" {
+		t.Errorf("expected %s, got %s", "1000.50", values.String.String())
+	}
+
+	if values.Number.String() != "-0.25" {
+		t.Errorf("expected %s, got %s", "-0.25", values.Number.String())
+	}
+
+	if !values.Empty.IsZero() || !values.Null.IsZero() {
+		t.Errorf("expected empty and null to be 0, got %s %s", values.Empty.String(), values.Null.String())
+	}
+
+	data, err := json.Marshal(values.String)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	if string(data) != `"1000.50"` {
+		t.Errorf("expected %s, got %s", `"1000.50"`, data)
+	}
+
+	var separated, empty Decimal
+	if err := json.Unmarshal([]byte(`"1,234.5"`), &separated); err != nil || separated.String() != "1234.5" {
+		t.Errorf("expected %s, got %s (%v)", "1234.5", separated.String(), err)
+	}
+	if err := json.Unmarshal([]byte(`""`), &empty); err != nil || !empty.IsZero() {
+		t.Errorf("expected %s, got %s (%v)", "0", empty.String(), err)
+	}
+
+	for _, input := range []string{`"1.2.3"`, `"NaN"`, `"n/a"`} {
+		var invalid Decimal
+		if err := json.Unmarshal([]byte(input), &invalid); !errors.Is(err, ErrInvalidDecimal) {
+			t.Errorf("%s: expected %v, got %v", input, ErrInvalidDecimal, err)
+		}
+	}
+}
@@ -0,0 +1,510 @@
+package tastytrade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// dxlinkVersion is the protocol version announced in the SETUP message.
+	dxlinkVersion = "0.1-DXF-JS/0.3.0"
+	// dxlinkKeepaliveTimeout is the keepalive timeout in seconds announced to and accepted from the server.
+	dxlinkKeepaliveTimeout = 60
+	// dxlinkFeedChannel is the channel number used for the market data feed.
+	dxlinkFeedChannel = 1
+	// defaultKeepaliveInterval is how often the DXLink streamer sends keepalives.
+	defaultKeepaliveInterval = 30 * time.Second
+)
+
+var (
+	// ErrDXLinkClosed is returned by DXLinkStreamer methods after the connection was closed.
+	ErrDXLinkClosed = errors.New("dxlink streamer closed")
+	// ErrDXLinkTokenRefresh is returned by DXLinkStreamer.Connect when Token is set without RefreshToken.
+	ErrDXLinkTokenRefresh = errors.New("dxlink streamer with a fixed Token requires RefreshToken to reconnect")
+)
+
+// QuoteToken contains the credentials for the DXLink market data websocket.
+type QuoteToken struct {
+	Token     string `json:"token"`      // Token sent in the DXLink AUTH message
+	DXLinkURL string `json:"dxlink-url"` // Websocket URL of the DXLink server
+	Level     string `json:"level"`      // Market data entitlement level (e.g., "api")
+	IssuedAt  string `json:"issued-at"`  // Token issue timestamp
+	ExpiresAt string `json:"expires-at"` // Token expiration timestamp (tokens are valid for 24 hours)
+}
+
+// QuoteTokenResponse represents the response structure returned by GetQuoteToken.
+type QuoteTokenResponse struct {
+	Data    QuoteToken `json:"data"`    // Quote token data
+	Context string     `json:"context"` // API context identifier
+}
+
+// GetQuoteToken retrieves a token for the DXLink market data websocket.
+// Returns a QuoteTokenResponse containing the token and the websocket URL to use it with.
+func (api *TastytradeAPI) GetQuoteToken() (QuoteTokenResponse, error) {
+	return api.GetQuoteTokenCtx(context.Background())
+}
+
+// GetQuoteTokenCtx is like GetQuoteToken but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetQuoteTokenCtx(ctx context.Context) (QuoteTokenResponse, error) {
+	urlVal := api.environment.QuoteTokenURL
+	if urlVal == "" || api.host != api.environment.APIURL {
+		urlVal = fmt.Sprintf("%s/api-quote-tokens", api.host)
+	}
+
+	var response QuoteTokenResponse
+	if err := api.fetchDataAndUnmarshal(ctx, urlVal, &response); err != nil {
+		return QuoteTokenResponse{}, err
+	}
+
+	return response, nil
+}
+
+// dxlinkMessage is a message exchanged with the DXLink server. Only the fields relevant to its type are set.
+type dxlinkMessage struct {
+	Type                    string               `json:"type"`
+	Channel                 int                  `json:"channel"`
+	Version                 string               `json:"version,omitempty"`
+	KeepaliveTimeout        int                  `json:"keepaliveTimeout,omitempty"`
+	AcceptKeepaliveTimeout  int                  `json:"acceptKeepaliveTimeout,omitempty"`
+	Token                   string               `json:"token,omitempty"`
+	State                   string               `json:"state,omitempty"`
+	Service                 string               `json:"service,omitempty"`
+	Parameters              map[string]string    `json:"parameters,omitempty"`
+	AcceptAggregationPeriod float64              `json:"acceptAggregationPeriod,omitempty"`
+	AcceptDataFormat        string               `json:"acceptDataFormat,omitempty"`
+	AcceptEventFields       map[string][]string  `json:"acceptEventFields,omitempty"`
+	EventFields             map[string][]string  `json:"eventFields,omitempty"`
+	Reset                   bool                 `json:"reset,omitempty"`
+	Add                     []dxlinkSubscription `json:"add,omitempty"`
+	Remove                  []dxlinkSubscription `json:"remove,omitempty"`
+	Data                    json.RawMessage      `json:"data,omitempty"`
+	Error                   string               `json:"error,omitempty"`
+	Message                 string               `json:"message,omitempty"`
+}
+
+// dxlinkSubscription identifies an event type and symbol in a FEED_SUBSCRIPTION message.
+type dxlinkSubscription struct {
+	Type     string `json:"type"`
+	Symbol   string `json:"symbol"`
+	FromTime int64  `json:"fromTime,omitempty"` // Start of the requested history (Unix milliseconds, Candle only)
+}
+
+// DXLinkStreamer streams live market data events from tastytrade's DXLink server.
+// Create one with NewDXLinkStreamer, start it with Connect, subscribe to streamer symbols
+// (the StreamerSymbol of OptionDataDetailed, Future or EquityData) and read from its channels:
+//
+//	streamer := api.NewDXLinkStreamer()
+//	if err := streamer.Connect(ctx); err != nil {
+//		...
+//	}
+//	defer streamer.Close()
+//	streamer.Subscribe(ctx, tastytrade.EventTypeQuote, "AAPL", ".AAPL260116C5")
+//	for quote := range streamer.Quotes {
+//		...
+//	}
+//
+// Dropped connections are re-established with a fresh quote token and subscriptions are replayed;
+// Events reports each reconnect. Each event channel except Candles must be drained; a full channel blocks
+// delivery of every later event.
+type DXLinkStreamer struct {
+	URL                  string        // Websocket URL (defaults to the URL returned with the quote token)
+	Token                string        // Quote token (fetched with GetQuoteToken on every connect when empty; requires RefreshToken)
+	KeepaliveInterval    time.Duration // Interval between keepalives (default: 30 seconds)
+	MaxReconnectAttempts int           // Reconnect attempts per outage before the streamer stops (0 retries forever)
+
+	// RefreshToken returns a new quote token before every reconnect. It is required when Token is set,
+	// since quote tokens expire and a reconnect with a stale token is rejected.
+	RefreshToken func(ctx context.Context) (string, error)
+
+	Quotes    chan QuoteEvent   // Quote events
+	Trades    chan TradeEvent   // Trade events
+	Greeks    chan GreeksEvent  // Greeks events
+	Summaries chan SummaryEvent // Summary events
+	Profiles  chan ProfileEvent // Profile events
+	Candles   chan Candle       // Candle events of Candle subscriptions (dropped rather than waited on when full)
+	Events    chan SessionEvent // Connection events; SessionReconnected means events may have been missed
+
+	api     *TastytradeAPI
+	session *WebsocketSession
+
+	// Set on Connect: the token (and URL) are fetched again before every reconnect unless given by the caller.
+	fetchToken    bool
+	fetchURL      bool
+	token         string
+	authenticated bool // Whether the first connection attempt was authenticated
+
+	fieldsMu sync.RWMutex
+	fields   map[string][]string
+
+	subscriptionsMu sync.Mutex
+	subscriptions   map[dxlinkSubscription]struct{}
+
+	candlesMu sync.Mutex
+	snapshots map[string]*candleSnapshot
+}
+
+// NewDXLinkStreamer creates a DXLink market data streamer.
+func (api *TastytradeAPI) NewDXLinkStreamer() *DXLinkStreamer {
+	fields := make(map[string][]string, len(dxlinkEventFields))
+	for eventType, eventFields := range dxlinkEventFields {
+		fields[eventType] = eventFields
+	}
+	session := api.NewWebsocketSession("")
+	s := &DXLinkStreamer{
+		KeepaliveInterval: defaultKeepaliveInterval,
+		Quotes:            make(chan QuoteEvent, streamerChannelSize),
+		Trades:            make(chan TradeEvent, streamerChannelSize),
+		Greeks:            make(chan GreeksEvent, streamerChannelSize),
+		Summaries:         make(chan SummaryEvent, streamerChannelSize),
+		Profiles:          make(chan ProfileEvent, streamerChannelSize),
+		Candles:           make(chan Candle, streamerChannelSize),
+		Events:            session.Events,
+		api:               api,
+		session:           session,
+		fields:            fields,
+		subscriptions:     make(map[dxlinkSubscription]struct{}),
+		snapshots:         make(map[string]*candleSnapshot),
+	}
+	session.Authenticate = s.authenticate
+	session.Handshake = s.handshake
+	session.Keepalive = func(ctx context.Context, conn *WebsocketConn) error {
+		return conn.WriteJSON(dxlinkMessage{Type: "KEEPALIVE"})
+	}
+	session.Handle = s.handle
+	session.Subscribe(context.Background(), "feed", s.replay)
+	return s
+}
+
+// Connect obtains a quote token if needed, opens the websocket and performs the SETUP, AUTH,
+// CHANNEL_REQUEST and FEED_SETUP handshake before starting the read and keepalive loops.
+// ctx bounds the token request, the dial and the handshake only; use Close to stop the streamer.
+func (s *DXLinkStreamer) Connect(ctx context.Context) error {
+	if s.Token != "" && s.RefreshToken == nil {
+		return ErrDXLinkTokenRefresh
+	}
+	s.authenticated = false
+	s.fetchToken = s.Token == ""
+	s.fetchURL = s.URL == ""
+	s.token = s.Token
+	s.session.URL = s.URL
+	s.session.KeepaliveInterval = s.KeepaliveInterval
+	s.session.ReadTimeout = dxlinkKeepaliveTimeout * time.Second
+	s.session.MaxReconnectAttempts = s.MaxReconnectAttempts
+
+	if err := s.session.Start(ctx); err != nil {
+		return err
+	}
+
+	s.api.log(ctx, slog.LevelInfo, "tastytrade dxlink streamer connected", "url", s.session.URL)
+	return nil
+}
+
+// Subscribe starts streaming events of the given type (e.g., EventTypeQuote) for the given streamer symbols.
+// Subscriptions are kept across reconnects; subscribing before Connect is allowed.
+// If ctx is done before the subscription was sent, Subscribe returns ctx.Err() and subscribes to nothing.
+func (s *DXLinkStreamer) Subscribe(ctx context.Context, eventType string, symbols ...string) error {
+	return s.subscribe(ctx, dxlinkSubscriptions(eventType, symbols))
+}
+
+// Unsubscribe stops streaming events of the given type for the given streamer symbols.
+// If ctx is done before the request was sent, Unsubscribe returns ctx.Err(); the symbols are then
+// still removed from the subscriptions replayed after the reconnect that an aborted write causes.
+func (s *DXLinkStreamer) Unsubscribe(ctx context.Context, eventType string, symbols ...string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	subscriptions := dxlinkSubscriptions(eventType, symbols)
+	s.subscriptionsMu.Lock()
+	for _, subscription := range subscriptions {
+		for existing := range s.subscriptions {
+			// Candle subscriptions also carry a start time, so match on type and symbol only.
+			if existing.Type == subscription.Type && existing.Symbol == subscription.Symbol {
+				delete(s.subscriptions, existing)
+			}
+		}
+	}
+	s.subscriptionsMu.Unlock()
+	return s.send(ctx, dxlinkMessage{Type: "FEED_SUBSCRIPTION", Channel: dxlinkFeedChannel, Remove: subscriptions})
+}
+
+// subscribe records subscriptions for replay and sends them. When ctx ends before they were sent,
+// the subscriptions added by this call are forgotten again.
+func (s *DXLinkStreamer) subscribe(ctx context.Context, subscriptions []dxlinkSubscription) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var added []dxlinkSubscription
+	s.subscriptionsMu.Lock()
+	for _, subscription := range subscriptions {
+		if _, ok := s.subscriptions[subscription]; !ok {
+			s.subscriptions[subscription] = struct{}{}
+			added = append(added, subscription)
+		}
+	}
+	s.subscriptionsMu.Unlock()
+
+	err := s.send(ctx, dxlinkMessage{Type: "FEED_SUBSCRIPTION", Channel: dxlinkFeedChannel, Add: subscriptions})
+	if err != nil && ctx.Err() != nil {
+		s.subscriptionsMu.Lock()
+		for _, subscription := range added {
+			delete(s.subscriptions, subscription)
+		}
+		s.subscriptionsMu.Unlock()
+	}
+	return err
+}
+
+// subscribed reports whether there is a subscription for eventType and symbol.
+func (s *DXLinkStreamer) subscribed(eventType, symbol string) bool {
+	s.subscriptionsMu.Lock()
+	defer s.subscriptionsMu.Unlock()
+	for subscription := range s.subscriptions {
+		if subscription.Type == eventType && subscription.Symbol == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+// Done returns a channel that is closed when the streamer stops, either through Close or because reconnecting failed.
+func (s *DXLinkStreamer) Done() <-chan struct{} {
+	return s.session.Done()
+}
+
+// Err returns the error that stopped the streamer, or nil if it is running or was closed with Close.
+func (s *DXLinkStreamer) Err() error {
+	return s.session.Err()
+}
+
+// Close closes the connection and stops the streamer.
+func (s *DXLinkStreamer) Close() error {
+	return s.session.Close()
+}
+
+// send writes a message on the current connection. While reconnecting the message is dropped,
+// since subscriptions are replayed once the connection is back.
+func (s *DXLinkStreamer) send(ctx context.Context, message dxlinkMessage) error {
+	err := s.session.SendCtx(ctx, message)
+	switch {
+	case errors.Is(err, ErrSessionClosed):
+		return ErrDXLinkClosed
+	case errors.Is(err, ErrSessionDisconnected):
+		return nil
+	}
+	return err
+}
+
+// authenticate fetches a fresh quote token before each dial. A token supplied by the caller is used
+// for the first connection only; reconnects get a new one from RefreshToken.
+func (s *DXLinkStreamer) authenticate(ctx context.Context) (string, error) {
+	if !s.fetchToken && s.authenticated {
+		token, err := s.RefreshToken(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to refresh quote token: %w", err)
+		}
+		s.token = token
+	}
+	s.authenticated = true
+	if !s.fetchToken && !s.fetchURL {
+		return "", nil
+	}
+	token, err := s.api.GetQuoteTokenCtx(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get quote token: %w", err)
+	}
+	if s.fetchToken {
+		s.token = token.Data.Token
+	}
+	if s.fetchURL {
+		return token.Data.DXLinkURL, nil
+	}
+	return "", nil
+}
+
+// handshake performs the SETUP, AUTH, CHANNEL_REQUEST and FEED_SETUP exchange.
+func (s *DXLinkStreamer) handshake(ctx context.Context, conn *WebsocketConn) error {
+	err := conn.WriteJSON(dxlinkMessage{
+		Type:                   "SETUP",
+		Version:                dxlinkVersion,
+		KeepaliveTimeout:       dxlinkKeepaliveTimeout,
+		AcceptKeepaliveTimeout: dxlinkKeepaliveTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	if err := conn.WriteJSON(dxlinkMessage{Type: "AUTH", Token: s.token}); err != nil {
+		return err
+	}
+	if err := awaitDXLink(conn, func(m dxlinkMessage) bool { return m.Type == "AUTH_STATE" && m.State == "AUTHORIZED" }); err != nil {
+		return fmt.Errorf("dxlink authorization failed: %w", err)
+	}
+
+	err = conn.WriteJSON(dxlinkMessage{
+		Type:       "CHANNEL_REQUEST",
+		Channel:    dxlinkFeedChannel,
+		Service:    "FEED",
+		Parameters: map[string]string{"contract": "AUTO"},
+	})
+	if err != nil {
+		return err
+	}
+	if err := awaitDXLink(conn, func(m dxlinkMessage) bool { return m.Type == "CHANNEL_OPENED" && m.Channel == dxlinkFeedChannel }); err != nil {
+		return fmt.Errorf("dxlink channel request failed: %w", err)
+	}
+
+	s.fieldsMu.RLock()
+	acceptFields := make(map[string][]string, len(s.fields))
+	for eventType, fields := range s.fields {
+		acceptFields[eventType] = fields
+	}
+	s.fieldsMu.RUnlock()
+
+	return conn.WriteJSON(dxlinkMessage{
+		Type:                    "FEED_SETUP",
+		Channel:                 dxlinkFeedChannel,
+		AcceptAggregationPeriod: 0.1,
+		AcceptDataFormat:        "COMPACT",
+		AcceptEventFields:       acceptFields,
+	})
+}
+
+// replay sends all current subscriptions on a new connection.
+func (s *DXLinkStreamer) replay(ctx context.Context, conn *WebsocketConn) error {
+	s.subscriptionsMu.Lock()
+	subscriptions := make([]dxlinkSubscription, 0, len(s.subscriptions))
+	for subscription := range s.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	s.subscriptionsMu.Unlock()
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	return conn.WriteJSON(dxlinkMessage{Type: "FEED_SUBSCRIPTION", Channel: dxlinkFeedChannel, Reset: true, Add: subscriptions})
+}
+
+// awaitDXLink reads messages until one satisfies done. It fails on ERROR messages and
+// on an AUTH_STATE of UNAUTHORIZED received after the token was sent.
+func awaitDXLink(conn *WebsocketConn, done func(dxlinkMessage) bool) error {
+	unauthorizedSeen := false
+	for {
+		var message dxlinkMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			return err
+		}
+		if done(message) {
+			return nil
+		}
+		switch message.Type {
+		case "ERROR":
+			return fmt.Errorf("%s: %s", message.Error, message.Message)
+		case "AUTH_STATE":
+			// The server announces UNAUTHORIZED once after SETUP; a second one rejects the token.
+			if message.State == "UNAUTHORIZED" {
+				if unauthorizedSeen {
+					return errors.New("token rejected")
+				}
+				unauthorizedSeen = true
+			}
+		}
+	}
+}
+
+// handle decodes an incoming message. A revoked token drops the connection so that
+// the session reconnects with a fresh one.
+func (s *DXLinkStreamer) handle(data []byte) error {
+	var message dxlinkMessage
+	if err := json.Unmarshal(data, &message); err != nil {
+		s.api.log(context.Background(), slog.LevelWarn, "tastytrade dxlink message decode failed", "error", err)
+		return nil
+	}
+	switch message.Type {
+	case "FEED_CONFIG":
+		if len(message.EventFields) > 0 {
+			s.fieldsMu.Lock()
+			for eventType, fields := range message.EventFields {
+				s.fields[eventType] = fields
+			}
+			s.fieldsMu.Unlock()
+		}
+	case "FEED_DATA":
+		if err := s.dispatch(message.Data); err != nil {
+			s.api.log(context.Background(), slog.LevelWarn, "tastytrade dxlink message decode failed", "error", err)
+		}
+	case "ERROR":
+		s.api.log(context.Background(), slog.LevelWarn, "tastytrade dxlink error", "error", message.Error, "message", message.Message)
+	case "AUTH_STATE":
+		if message.State == "UNAUTHORIZED" {
+			return errors.New("dxlink token rejected")
+		}
+	}
+	return nil
+}
+
+// dispatch decodes COMPACT FEED_DATA, a list of alternating event types and flattened field values
+// (e.g. ["Quote", ["Quote", "AAPL", 1.0, 1.1, 100, 200, "Quote", "SPY", ...]]), and delivers the events.
+func (s *DXLinkStreamer) dispatch(data json.RawMessage) error {
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+
+	for i := 0; i+1 < len(parts); i += 2 {
+		var eventType string
+		if err := json.Unmarshal(parts[i], &eventType); err != nil {
+			return err
+		}
+		var values []interface{}
+		if err := json.Unmarshal(parts[i+1], &values); err != nil {
+			return err
+		}
+
+		s.fieldsMu.RLock()
+		fields := s.fields[eventType]
+		s.fieldsMu.RUnlock()
+		if len(fields) == 0 {
+			continue
+		}
+
+		for start := 0; start+len(fields) <= len(values); start += len(fields) {
+			event := make(map[string]interface{}, len(fields))
+			for j, field := range fields {
+				event[field] = values[start+j]
+			}
+			s.deliver(eventType, event)
+		}
+	}
+	return nil
+}
+
+// deliver converts a decoded event to its typed form and sends it on the matching channel.
+func (s *DXLinkStreamer) deliver(eventType string, event map[string]interface{}) {
+	switch eventType {
+	case EventTypeQuote:
+		deliver(s.session.Done(), s.Quotes, newQuoteEvent(event))
+	case EventTypeTrade:
+		deliver(s.session.Done(), s.Trades, newTradeEvent(event))
+	case EventTypeGreeks:
+		deliver(s.session.Done(), s.Greeks, newGreeksEvent(event))
+	case EventTypeSummary:
+		deliver(s.session.Done(), s.Summaries, newSummaryEvent(event))
+	case EventTypeProfile:
+		deliver(s.session.Done(), s.Profiles, newProfileEvent(event))
+	case EventTypeCandle:
+		s.handleCandle(event)
+	}
+}
+
+// dxlinkSubscriptions builds FEED_SUBSCRIPTION entries for the given event type and symbols.
+func dxlinkSubscriptions(eventType string, symbols []string) []dxlinkSubscription {
+	subscriptions := make([]dxlinkSubscription, 0, len(symbols))
+	for _, symbol := range symbols {
+		subscriptions = append(subscriptions, dxlinkSubscription{Type: eventType, Symbol: symbol})
+	}
+	return subscriptions
+}
@@ -0,0 +1,159 @@
+package tastytrade
+
+import (
+	"math"
+	"strconv"
+)
+
+// DXLink event types accepted by DXLinkStreamer.Subscribe.
+const (
+	EventTypeQuote   = "Quote"   // Best bid and offer
+	EventTypeTrade   = "Trade"   // Last trade and day volume
+	EventTypeGreeks  = "Greeks"  // Option greeks and implied volatility
+	EventTypeSummary = "Summary" // Daily open/high/low, previous close and open interest
+	EventTypeProfile = "Profile" // Instrument description, trading status and 52 week range
+	EventTypeCandle  = "Candle"  // OHLCV bars; use GetCandles, or Subscribe with a CandleSymbol
+)
+
+// dxlinkEventFields lists the fields requested for each event type in COMPACT format.
+// eventType and eventSymbol must come first.
+var dxlinkEventFields = map[string][]string{
+	EventTypeQuote:   {"eventType", "eventSymbol", "bidPrice", "askPrice", "bidSize", "askSize"},
+	EventTypeTrade:   {"eventType", "eventSymbol", "price", "dayVolume", "size"},
+	EventTypeGreeks:  {"eventType", "eventSymbol", "price", "volatility", "delta", "gamma", "theta", "rho", "vega"},
+	EventTypeSummary: {"eventType", "eventSymbol", "openInterest", "dayOpenPrice", "dayHighPrice", "dayLowPrice", "prevDayClosePrice"},
+	EventTypeProfile: {"eventType", "eventSymbol", "description", "tradingStatus", "high52WeekPrice", "low52WeekPrice"},
+	EventTypeCandle:  {"eventType", "eventSymbol", "eventFlags", "time", "open", "high", "low", "close", "volume", "vwap", "impVolatility", "openInterest"},
+}
+
+// QuoteEvent is a DXLink Quote event. Prices are NaN when the side is empty.
+type QuoteEvent struct {
+	Symbol   string  // Streamer symbol (e.g., "AAPL", ".AAPL260116C5", "/ESZ24:XCME")
+	BidPrice float64 // Best bid price
+	AskPrice float64 // Best ask price
+	BidSize  float64 // Size at the best bid
+	AskSize  float64 // Size at the best ask
+}
+
+// TradeEvent is a DXLink Trade event.
+type TradeEvent struct {
+	Symbol    string  // Streamer symbol
+	Price     float64 // Last trade price
+	DayVolume float64 // Volume traded today
+	Size      float64 // Size of the last trade
+}
+
+// GreeksEvent is a DXLink Greeks event for an option.
+type GreeksEvent struct {
+	Symbol     string  // Option streamer symbol
+	Price      float64 // Theoretical option price
+	Volatility float64 // Implied volatility
+	Delta      float64 // Delta
+	Gamma      float64 // Gamma
+	Theta      float64 // Theta
+	Rho        float64 // Rho
+	Vega       float64 // Vega
+}
+
+// SummaryEvent is a DXLink Summary event.
+type SummaryEvent struct {
+	Symbol            string  // Streamer symbol
+	OpenInterest      float64 // Open interest (options and futures)
+	DayOpenPrice      float64 // Today's open
+	DayHighPrice      float64 // Today's high
+	DayLowPrice       float64 // Today's low
+	PrevDayClosePrice float64 // Previous day's close
+}
+
+// ProfileEvent is a DXLink Profile event.
+type ProfileEvent struct {
+	Symbol          string  // Streamer symbol
+	Description     string  // Instrument description
+	TradingStatus   string  // Trading status (e.g., "ACTIVE", "HALTED")
+	High52WeekPrice float64 // 52 week high
+	Low52WeekPrice  float64 // 52 week low
+}
+
+// newQuoteEvent converts decoded COMPACT fields to a QuoteEvent.
+func newQuoteEvent(fields map[string]interface{}) QuoteEvent {
+	return QuoteEvent{
+		Symbol:   dxlinkString(fields["eventSymbol"]),
+		BidPrice: dxlinkFloat(fields["bidPrice"]),
+		AskPrice: dxlinkFloat(fields["askPrice"]),
+		BidSize:  dxlinkFloat(fields["bidSize"]),
+		AskSize:  dxlinkFloat(fields["askSize"]),
+	}
+}
+
+// newTradeEvent converts decoded COMPACT fields to a TradeEvent.
+func newTradeEvent(fields map[string]interface{}) TradeEvent {
+	return TradeEvent{
+		Symbol:    dxlinkString(fields["eventSymbol"]),
+		Price:     dxlinkFloat(fields["price"]),
+		DayVolume: dxlinkFloat(fields["dayVolume"]),
+		Size:      dxlinkFloat(fields["size"]),
+	}
+}
+
+// newGreeksEvent converts decoded COMPACT fields to a GreeksEvent.
+func newGreeksEvent(fields map[string]interface{}) GreeksEvent {
+	return GreeksEvent{
+		Symbol:     dxlinkString(fields["eventSymbol"]),
+		Price:      dxlinkFloat(fields["price"]),
+		Volatility: dxlinkFloat(fields["volatility"]),
+		Delta:      dxlinkFloat(fields["delta"]),
+		Gamma:      dxlinkFloat(fields["gamma"]),
+		Theta:      dxlinkFloat(fields["theta"]),
+		Rho:        dxlinkFloat(fields["rho"]),
+		Vega:       dxlinkFloat(fields["vega"]),
+	}
+}
+
+// newSummaryEvent converts decoded COMPACT fields to a SummaryEvent.
+func newSummaryEvent(fields map[string]interface{}) SummaryEvent {
+	return SummaryEvent{
+		Symbol:            dxlinkString(fields["eventSymbol"]),
+		OpenInterest:      dxlinkFloat(fields["openInterest"]),
+		DayOpenPrice:      dxlinkFloat(fields["dayOpenPrice"]),
+		DayHighPrice:      dxlinkFloat(fields["dayHighPrice"]),
+		DayLowPrice:       dxlinkFloat(fields["dayLowPrice"]),
+		PrevDayClosePrice: dxlinkFloat(fields["prevDayClosePrice"]),
+	}
+}
+
+// newProfileEvent converts decoded COMPACT fields to a ProfileEvent.
+func newProfileEvent(fields map[string]interface{}) ProfileEvent {
+	return ProfileEvent{
+		Symbol:          dxlinkString(fields["eventSymbol"]),
+		Description:     dxlinkString(fields["description"]),
+		TradingStatus:   dxlinkString(fields["tradingStatus"]),
+		High52WeekPrice: dxlinkFloat(fields["high52WeekPrice"]),
+		Low52WeekPrice:  dxlinkFloat(fields["low52WeekPrice"]),
+	}
+}
+
+// dxlinkFloat converts a DXLink field value to float64. DXLink sends missing values as the
+// strings "NaN", "Infinity" or "-Infinity"; anything that cannot be parsed becomes NaN.
+func dxlinkFloat(v interface{}) float64 {
+	switch val := v.(type) {
+	case float64:
+		return val
+	case string:
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			return f
+		}
+	}
+	return math.NaN()
+}
+
+// dxlinkString converts a DXLink field value to string.
+func dxlinkString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
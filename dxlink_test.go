@@ -0,0 +1,219 @@
+package tastytrade
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// newDXLinkServer starts a server issuing quote tokens on /api-quote-tokens and speaking a minimal
+// DXLink protocol on /realtime. Every FEED_SUBSCRIPTION is answered with the given FEED_DATA payload.
+func newDXLinkServer(t *testing.T, feedData string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api-quote-tokens" {
+			dxlinkURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/realtime"
+			rw.Write([]byte(`{"context": "/api-quote-tokens", "data": {"token": "quotetoken", "dxlink-url": "` + dxlinkURL + `", "level": "api"}}`))
+			return
+		}
+
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			t.Errorf("expected nil, got %v", err)
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var message dxlinkMessage
+			if err := conn.ReadJSON(&message); err != nil {
+				return
+			}
+			switch message.Type {
+			case "SETUP":
+				conn.WriteJSON(dxlinkMessage{Type: "SETUP", Version: "1.0"})
+				conn.WriteJSON(dxlinkMessage{Type: "AUTH_STATE", State: "UNAUTHORIZED"})
+			case "AUTH":
+				if message.Token != "quotetoken" {
+					conn.WriteJSON(dxlinkMessage{Type: "AUTH_STATE", State: "UNAUTHORIZED"})
+					continue
+				}
+				conn.WriteJSON(dxlinkMessage{Type: "AUTH_STATE", State: "AUTHORIZED"})
+			case "CHANNEL_REQUEST":
+				conn.WriteJSON(dxlinkMessage{Type: "CHANNEL_OPENED", Channel: message.Channel})
+			case "FEED_SETUP":
+				if message.AcceptDataFormat != "COMPACT" || len(message.AcceptEventFields[EventTypeQuote]) == 0 {
+					t.Errorf("unexpected feed setup %+v", message)
+				}
+				conn.WriteJSON(dxlinkMessage{Type: "FEED_CONFIG", Channel: message.Channel, EventFields: message.AcceptEventFields})
+			case "FEED_SUBSCRIPTION":
+				conn.WriteMessage(websocket.TextMessage, []byte(`{"type": "FEED_DATA", "channel": 1, "data": `+feedData+`}`))
+			}
+		}
+	}))
+	return server
+}
+
+func TestDXLinkStreamer(t *testing.T) {
+	server := newDXLinkServer(t, `["Quote", ["Quote", "AAPL", 220.1, 220.2, 100, 200, "Quote", "SPY", 560.5, 560.6, "NaN", 300],
+		"Greeks", ["Greeks", ".AAPL260116C5", 215.0, 0.3, 0.99, 0.0001, -0.01, 0.02, "NaN"]]`)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	streamer := api.NewDXLinkStreamer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer streamer.Close()
+
+	if err := streamer.Subscribe(ctx, EventTypeQuote, "AAPL", "SPY"); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	var quotes []QuoteEvent
+	for len(quotes) < 2 {
+		select {
+		case quote := <-streamer.Quotes:
+			quotes = append(quotes, quote)
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for quotes")
+		}
+	}
+
+	if quotes[0].Symbol != "AAPL" || quotes[0].BidPrice != 220.1 || quotes[0].AskSize != 200 {
+		t.Errorf("unexpected quote %+v", quotes[0])
+	}
+
+	if quotes[1].Symbol != "SPY" || !math.IsNaN(quotes[1].BidSize) {
+		t.Errorf("unexpected quote %+v", quotes[1])
+	}
+
+	select {
+	case greeks := <-streamer.Greeks:
+		if greeks.Symbol != ".AAPL260116C5" || greeks.Delta != 0.99 || !math.IsNaN(greeks.Vega) {
+			t.Errorf("unexpected greeks %+v", greeks)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for greeks")
+	}
+}
+
+func TestDXLinkStreamerRejectedToken(t *testing.T) {
+	server := newDXLinkServer(t, `[]`)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	streamer := api.NewDXLinkStreamer()
+	streamer.URL = "ws" + strings.TrimPrefix(server.URL, "http") + "/realtime"
+	streamer.Token = "expiredtoken"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != ErrDXLinkTokenRefresh {
+		t.Errorf("expected %v, got %v", ErrDXLinkTokenRefresh, err)
+	}
+
+	streamer.RefreshToken = func(ctx context.Context) (string, error) {
+		return "expiredtoken", nil
+	}
+	if err := streamer.Connect(ctx); err == nil {
+		t.Errorf("expected authorization error, got nil")
+	}
+}
+
+func TestDXLinkStreamerRefreshesToken(t *testing.T) {
+	server := newDXLinkServer(t, `[]`)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	streamer := api.NewDXLinkStreamer()
+	streamer.URL = "ws" + strings.TrimPrefix(server.URL, "http") + "/realtime"
+	streamer.Token = "quotetoken"
+	var refreshes atomic.Int64
+	streamer.RefreshToken = func(ctx context.Context) (string, error) {
+		refreshes.Add(1)
+		return "quotetoken", nil
+	}
+	streamer.session.MinReconnectDelay = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := streamer.Connect(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer streamer.Close()
+
+	if refreshes.Load() != 0 {
+		t.Errorf("expected %d, got %d", 0, refreshes.Load())
+	}
+
+	// Drop the connection to force a reconnect.
+	streamer.session.mu.Lock()
+	streamer.session.conn.conn.Close()
+	streamer.session.mu.Unlock()
+
+	for {
+		select {
+		case event := <-streamer.Events:
+			if event.Type != SessionReconnected {
+				continue
+			}
+			if refreshes.Load() != 1 {
+				t.Errorf("expected %d, got %d", 1, refreshes.Load())
+			}
+			return
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for reconnect")
+		}
+	}
+}
+
+func TestDXLinkStreamerSubscribeCancelled(t *testing.T) {
+	server := newDXLinkServer(t, `[]`)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	streamer := api.NewDXLinkStreamer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := streamer.Subscribe(ctx, EventTypeQuote, "AAPL"); err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+
+	if streamer.subscribed(EventTypeQuote, "AAPL") {
+		t.Errorf("expected no subscription after cancelled Subscribe")
+	}
+
+	if err := streamer.Unsubscribe(ctx, EventTypeQuote, "AAPL"); err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestGetQuoteToken(t *testing.T) {
+	server := newDXLinkServer(t, `[]`)
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	resp, err := api.GetQuoteToken()
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Token != "quotetoken" {
+		t.Errorf("expected %s, got %s", "quotetoken", resp.Data.Token)
+	}
+}
@@ -0,0 +1,39 @@
+package tastytrade
+
+// Effect is the direction of an amount the API reports unsigned, such as a transaction value or a realized gain.
+// A Debit takes money out of the account and a Credit puts it in.
+type Effect string
+
+// Effects reported alongside amounts and accepted in Order.PriceEffect and Order.ValueEffect.
+const (
+	EffectCredit Effect = "Credit" // The amount is added to the account
+	EffectDebit  Effect = "Debit"  // The amount is taken from the account
+	EffectNone   Effect = "None"   // The amount has no effect (usually zero)
+)
+
+// Sign returns +1 for a Credit, -1 for a Debit and 0 for None or an unknown effect.
+func (e Effect) Sign() int {
+	switch e {
+	case EffectCredit:
+		return 1
+	case EffectDebit:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Apply returns amount signed by e: positive for a Credit, negative for a Debit and 0 for None.
+// An empty or unknown effect returns amount unchanged, since there is nothing to sign it by.
+func (e Effect) Apply(amount Decimal) Decimal {
+	switch e {
+	case EffectCredit:
+		return amount.Abs()
+	case EffectDebit:
+		return amount.Abs().Neg()
+	case EffectNone:
+		return Decimal{}
+	default:
+		return amount
+	}
+}
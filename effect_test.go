@@ -0,0 +1,49 @@
+package tastytrade
+
+import "testing"
+
+func TestEffectApply(t *testing.T) {
+	amount := MustParseDecimal("12.50")
+	tests := []struct {
+		effect   Effect
+		amount   Decimal
+		expected string
+		sign     int
+	}{
+		{EffectCredit, amount, "12.50", 1},
+		{EffectDebit, amount, "-12.50", -1},
+		{EffectDebit, amount.Neg(), "-12.50", -1},
+		{EffectNone, amount, "0", 0},
+		{"", amount, "12.50", 0},
+	}
+	for _, test := range tests {
+		if signed := test.effect.Apply(test.amount); signed.String() != test.expected {
+			t.Errorf("expected %s for %q, got %s", test.expected, test.effect, signed.String())
+		}
+		if test.effect.Sign() != test.sign {
+			t.Errorf("expected %d for %q, got %d", test.sign, test.effect, test.effect.Sign())
+		}
+	}
+}
+
+func TestPositionSignedRealized(t *testing.T) {
+	position := Position{
+		RealizedDayGain:       MustParseDecimal("40.5"),
+		RealizedDayGainEffect: EffectCredit,
+		RealizedToday:         MustParseDecimal("15"),
+		RealizedTodayEffect:   EffectDebit,
+	}
+
+	if gain := position.SignedRealizedDayGain(); gain.String() != "40.5" {
+		t.Errorf("expected %s, got %s", "40.5", gain.String())
+	}
+
+	if today := position.SignedRealizedToday(); today.String() != "-15" {
+		t.Errorf("expected %s, got %s", "-15", today.String())
+	}
+
+	balances := BalanceData{PendingCash: MustParseDecimal("100"), PendingCashEffect: EffectNone}
+	if pending := balances.SignedPendingCash(); !pending.IsZero() {
+		t.Errorf("expected %s, got %s", "0", pending.String())
+	}
+}
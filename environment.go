@@ -0,0 +1,55 @@
+package tastytrade
+
+import (
+	"errors"
+)
+
+// ErrProductionWritesDisabled is returned by state-mutating calls (such as SubmitBacktest)
+// when the client targets the production environment without WithProductionWrites(true).
+var ErrProductionWritesDisabled = errors.New("tastytrade: state-mutating request refused in production; enable it with WithProductionWrites(true)")
+
+// Environment describes a Tastytrade API environment and the endpoints that belong to it.
+// Switching environments changes the REST host, the account streamer and the quote token endpoint together.
+type Environment struct {
+	Name               string // Environment name (e.g., "production", "sandbox")
+	APIURL             string // Base URL of the REST API
+	AccountStreamerURL string // Websocket URL of the account streamer
+	QuoteTokenURL      string // URL of the endpoint issuing market data streamer tokens
+}
+
+var (
+	// Production is the live Tastytrade environment.
+	Production = Environment{
+		Name:               "production",
+		APIURL:             baseURL,
+		AccountStreamerURL: "wss://streamer.tastyworks.com",
+		QuoteTokenURL:      baseURL + "/api-quote-tokens",
+	}
+
+	// Sandbox is the Tastytrade certification environment used for testing order flow.
+	Sandbox = Environment{
+		Name:               "sandbox",
+		APIURL:             "https://api.cert.tastyworks.com",
+		AccountStreamerURL: "wss://streamer.cert.tastyworks.com",
+		QuoteTokenURL:      "https://api.cert.tastyworks.com/api-quote-tokens",
+	}
+)
+
+// IsProduction reports whether the environment is the live production environment.
+func (env Environment) IsProduction() bool {
+	return env.Name == Production.Name
+}
+
+// Environment returns the environment the client talks to.
+func (api *TastytradeAPI) Environment() Environment {
+	return api.environment
+}
+
+// checkWriteAllowed returns ErrProductionWritesDisabled if the client may not send
+// state-mutating requests in its current environment.
+func (api *TastytradeAPI) checkWriteAllowed() error {
+	if api.environment.IsProduction() && !api.productionWrites {
+		return ErrProductionWritesDisabled
+	}
+	return nil
+}
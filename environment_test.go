@@ -0,0 +1,78 @@
+package tastytrade
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSandboxEnvironment(t *testing.T) {
+	api := New(WithEnvironment(Sandbox))
+
+	if api.host != "https://api.cert.tastyworks.com" {
+		t.Errorf("expected %s, got %s", "https://api.cert.tastyworks.com", api.host)
+	}
+
+	if api.Environment().AccountStreamerURL != "wss://streamer.cert.tastyworks.com" {
+		t.Errorf("expected %s, got %s", "wss://streamer.cert.tastyworks.com", api.Environment().AccountStreamerURL)
+	}
+
+	if api.Environment().IsProduction() {
+		t.Errorf("expected sandbox not to be production")
+	}
+}
+
+func TestProductionWriteGuard(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		rw.Write([]byte(`{"context": "test", "data": {"id": "bt-1"}}`))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL))
+	_, err := api.SubmitBacktest(BacktestRequest{Symbol: "SPY"})
+
+	if !errors.Is(err, ErrProductionWritesDisabled) {
+		t.Errorf("expected %v, got %v", ErrProductionWritesDisabled, err)
+	}
+
+	if requests != 0 {
+		t.Errorf("expected %d, got %d", 0, requests)
+	}
+
+	api = New(WithEnvironment(Sandbox), WithHost(server.URL))
+	if _, err := api.SubmitBacktest(BacktestRequest{Symbol: "SPY"}); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	api = New(WithHost(server.URL), WithProductionWrites(true))
+	if _, err := api.SubmitBacktest(BacktestRequest{Symbol: "SPY"}); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	api = NewTastytradeAPI(server.URL)
+	if _, err := api.SubmitBacktest(BacktestRequest{Symbol: "SPY"}); err != nil {
+		t.Errorf("expected legacy constructor to allow writes, got %v", err)
+	}
+
+	if requests != 3 {
+		t.Errorf("expected %d, got %d", 3, requests)
+	}
+}
+
+func TestHostOverridesEnvironment(t *testing.T) {
+	for _, api := range []*TastytradeAPI{
+		New(WithHost("http://localhost:8080"), WithEnvironment(Sandbox)),
+		New(WithEnvironment(Sandbox), WithHost("http://localhost:8080")),
+	} {
+		if api.host != "http://localhost:8080" {
+			t.Errorf("expected %s, got %s", "http://localhost:8080", api.host)
+		}
+
+		if api.Environment().Name != Sandbox.Name {
+			t.Errorf("expected %s, got %s", Sandbox.Name, api.Environment().Name)
+		}
+	}
+}
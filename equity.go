@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -71,8 +72,13 @@ type ActiveEquityQueryParams struct {
 // Returns an EquityResponse containing detailed information about the equity including
 // trading characteristics, market information, and tick sizes.
 func (api *TastytradeAPI) GetEquityData(symbol string) (EquityResponse, error) {
+	return api.GetEquityDataCtx(context.Background(), symbol)
+}
+
+// GetEquityDataCtx is like GetEquityData but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetEquityDataCtx(ctx context.Context, symbol string) (EquityResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/equities/%s", api.host, symbol)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return EquityResponse{}, err
 	}
@@ -96,6 +102,11 @@ func (api *TastytradeAPI) GetEquityData(symbol string) (EquityResponse, error) {
 // is-index, or is-etf flags.
 // Returns an EquityListResponse containing a list of matching equity instruments.
 func (api *TastytradeAPI) ListEquities(params *EquityQueryParams) (EquityListResponse, error) {
+	return api.ListEquitiesCtx(context.Background(), params)
+}
+
+// ListEquitiesCtx is like ListEquities but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListEquitiesCtx(ctx context.Context, params *EquityQueryParams) (EquityListResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/equities", api.host)
 
 	if params != nil {
@@ -115,7 +126,7 @@ func (api *TastytradeAPI) ListEquities(params *EquityQueryParams) (EquityListRes
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return EquityListResponse{}, err
 	}
@@ -139,6 +150,11 @@ func (api *TastytradeAPI) ListEquities(params *EquityQueryParams) (EquityListRes
 // (per-page and page-offset).
 // Returns an EquityListResponse containing a list of active equity instruments.
 func (api *TastytradeAPI) ListActiveEquities(params *ActiveEquityQueryParams) (EquityListResponse, error) {
+	return api.ListActiveEquitiesCtx(context.Background(), params)
+}
+
+// ListActiveEquitiesCtx is like ListActiveEquities but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListActiveEquitiesCtx(ctx context.Context, params *ActiveEquityQueryParams) (EquityListResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/equities/active", api.host)
 
 	if params != nil {
@@ -155,7 +171,7 @@ func (api *TastytradeAPI) ListActiveEquities(params *ActiveEquityQueryParams) (E
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return EquityListResponse{}, err
 	}
@@ -0,0 +1,168 @@
+package tastytrade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// FieldError represents a single entry in the nested "errors" list of an API error payload.
+// Validation failures usually carry one entry per offending field.
+type FieldError struct {
+	Code    string `json:"code"`    // Machine readable error code (e.g., "invalid_value")
+	Message string `json:"message"` // Human readable error description
+	Domain  string `json:"domain"`  // Field or domain the error applies to, when provided
+	Reason  string `json:"reason"`  // Additional reason, when provided
+}
+
+// APIError represents a non-2xx response returned by the Tastytrade API.
+// It carries the decoded error payload together with details of the request that failed,
+// and can be retrieved from any error returned by the client with errors.As.
+type APIError struct {
+	StatusCode int          // HTTP status code of the response
+	Code       string       // Error code from the payload (e.g., "not_found", "validation_error")
+	Message    string       // Error message from the payload
+	Errors     []FieldError // Per-field errors from the payload
+	Method     string       // HTTP method of the failed request
+	URL        string       // URL of the failed request
+	RequestID  string       // Value of the X-Request-Id response header
+	Body       []byte       // Raw response body
+}
+
+// apiErrorPayload mirrors the error envelope returned by the API:
+// {"error": {"code": "...", "message": "...", "errors": [...]}}
+type apiErrorPayload struct {
+	Error struct {
+		Code    string       `json:"code"`
+		Message string       `json:"message"`
+		Errors  []FieldError `json:"errors"`
+	} `json:"error"`
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	kind := "client error"
+	if e.StatusCode >= 500 {
+		kind = "server error"
+	}
+	msg := fmt.Sprintf("%s occurred: status code %d", kind, e.StatusCode)
+	if e.Code != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Code)
+	}
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	if len(e.Errors) > 0 {
+		details := make([]string, 0, len(e.Errors))
+		for _, fieldErr := range e.Errors {
+			if fieldErr.Domain != "" {
+				details = append(details, fmt.Sprintf("%s: %s", fieldErr.Domain, fieldErr.Message))
+			} else {
+				details = append(details, fieldErr.Message)
+			}
+		}
+		msg = fmt.Sprintf("%s (%s)", msg, strings.Join(details, "; "))
+	}
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s [request-id %s]", msg, e.RequestID)
+	}
+	return msg
+}
+
+// ChallengeError is returned by the login methods when the account has two-factor authentication
+// enabled and the API requires a one-time password before it issues a session.
+// Pass it to SubmitOTP together with the code the user received to finish logging in.
+type ChallengeError struct {
+	ChallengeToken string    // Value of the X-Tastyworks-Challenge-Token response header
+	Err            *APIError // Underlying 403 response
+
+	credentials map[string]interface{} // Credentials of the login attempt, replayed by SubmitOTP
+}
+
+// Error implements the error interface.
+func (e *ChallengeError) Error() string {
+	return fmt.Sprintf("two-factor challenge required: %v", e.Err)
+}
+
+// Unwrap returns the underlying API error.
+func (e *ChallengeError) Unwrap() error {
+	return e.Err
+}
+
+// IsChallengeRequired reports whether err is a two-factor challenge that must be answered with SubmitOTP.
+func IsChallengeRequired(err error) bool {
+	var challengeErr *ChallengeError
+	return errors.As(err, &challengeErr)
+}
+
+// newAPIError builds an APIError from a failed response, decoding the error payload when present.
+// The response body is consumed but not closed.
+func newAPIError(req *http.Request, resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+	if req != nil {
+		apiErr.Method = req.Method
+		if req.URL != nil {
+			apiErr.URL = req.URL.String()
+		}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return apiErr
+	}
+	apiErr.Body = body
+
+	var payload apiErrorPayload
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Code = payload.Error.Code
+		apiErr.Message = payload.Error.Message
+		apiErr.Errors = payload.Error.Errors
+	}
+	return apiErr
+}
+
+// asAPIError reports whether err wraps an *APIError and returns it.
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsUnauthorized reports whether err is an API error caused by a missing or expired session (401).
+func IsUnauthorized(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusUnauthorized
+}
+
+// IsRateLimited reports whether err is an API error caused by rate limiting (429).
+func IsRateLimited(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
+// IsNotFound reports whether err is an API error for a missing resource (404).
+func IsNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsValidationError reports whether err is an API error caused by invalid request parameters.
+// This covers 422 responses as well as 400 responses carrying a validation error code or per-field errors.
+func IsValidationError(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusUnprocessableEntity || apiErr.Code == "validation_error" {
+		return true
+	}
+	return apiErr.StatusCode == http.StatusBadRequest && len(apiErr.Errors) > 0
+}
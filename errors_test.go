@@ -0,0 +1,101 @@
+package tastytrade
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIErrorDecodesPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-Request-Id", "req-123")
+		rw.WriteHeader(http.StatusUnprocessableEntity)
+		rw.Write([]byte(`{"error": {"code": "validation_error", "message": "Request validation failed", "errors": [{"domain": "symbol", "code": "invalid", "message": "is invalid"}]}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	_, err := api.GetAccountTradingStatus("123456")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %v", err)
+	}
+
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("expected %d, got %d", http.StatusUnprocessableEntity, apiErr.StatusCode)
+	}
+
+	if apiErr.Code != "validation_error" {
+		t.Errorf("expected %s, got %s", "validation_error", apiErr.Code)
+	}
+
+	if apiErr.Message != "Request validation failed" {
+		t.Errorf("expected %s, got %s", "Request validation failed", apiErr.Message)
+	}
+
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Domain != "symbol" {
+		t.Errorf("expected one field error for symbol, got %v", apiErr.Errors)
+	}
+
+	if apiErr.Method != "GET" {
+		t.Errorf("expected %s, got %s", "GET", apiErr.Method)
+	}
+
+	if apiErr.URL != server.URL+"/accounts/123456/trading-status" {
+		t.Errorf("expected %s, got %s", server.URL+"/accounts/123456/trading-status", apiErr.URL)
+	}
+
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("expected %s, got %s", "req-123", apiErr.RequestID)
+	}
+
+	if !IsValidationError(err) {
+		t.Errorf("expected IsValidationError to be true")
+	}
+
+	if IsNotFound(err) || IsUnauthorized(err) || IsRateLimited(err) {
+		t.Errorf("expected only IsValidationError to be true")
+	}
+}
+
+func TestAPIErrorStatusChecks(t *testing.T) {
+	tests := []struct {
+		status int
+		check  func(error) bool
+	}{
+		{http.StatusUnauthorized, IsUnauthorized},
+		{http.StatusTooManyRequests, IsRateLimited},
+		{http.StatusNotFound, IsNotFound},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(tt.status)
+		}))
+
+		api := NewTastytradeAPI(server.URL)
+		_, err := api.GetPositions("123456")
+		server.Close()
+
+		if !tt.check(err) {
+			t.Errorf("status %d: check returned false for %v", tt.status, err)
+		}
+	}
+}
+
+func TestAuthenticateReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusUnauthorized)
+		rw.Write([]byte(`{"error": {"code": "invalid_credentials", "message": "Invalid login"}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	err := api.Authenticate("testuser", "badpassword")
+
+	if !IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
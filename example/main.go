@@ -8,7 +8,7 @@ import (
 
 func main() {
 	// Example usage:
-	api := tastytrade.NewTastytradeAPI("")
+	api := tastytrade.New()
 
 	// Authenticate with Tastytrade API
 	err := api.Authenticate(os.Getenv("USER"), os.Getenv("PWD"))
@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -274,6 +275,11 @@ type FutureOptionDetailedResponse struct {
 // params can be nil to retrieve all futures, or can filter by symbol or product code.
 // Returns a FuturesQueryResponse containing a list of matching future contracts.
 func (api *TastytradeAPI) QueryFutures(params *FuturesQueryParams) (FuturesQueryResponse, error) {
+	return api.QueryFuturesCtx(context.Background(), params)
+}
+
+// QueryFuturesCtx is like QueryFutures but carries ctx on the outgoing request.
+func (api *TastytradeAPI) QueryFuturesCtx(ctx context.Context, params *FuturesQueryParams) (FuturesQueryResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/futures", api.host)
 
 	if params != nil {
@@ -287,7 +293,7 @@ func (api *TastytradeAPI) QueryFutures(params *FuturesQueryParams) (FuturesQuery
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FuturesQueryResponse{}, err
 	}
@@ -310,8 +316,13 @@ func (api *TastytradeAPI) QueryFutures(params *FuturesQueryParams) (FuturesQuery
 // Returns a FutureResponse containing detailed information about the future contract
 // including expiration, trading characteristics, and product details.
 func (api *TastytradeAPI) GetFuture(symbol string) (FutureResponse, error) {
+	return api.GetFutureCtx(context.Background(), symbol)
+}
+
+// GetFutureCtx is like GetFuture but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetFutureCtx(ctx context.Context, symbol string) (FutureResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/futures/%s", api.host, url.PathEscape(symbol))
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureResponse{}, err
 	}
@@ -334,8 +345,13 @@ func (api *TastytradeAPI) GetFuture(symbol string) (FutureResponse, error) {
 // Returns a FutureProductsResponse containing all available future products with
 // their configuration and roll information.
 func (api *TastytradeAPI) ListFutureProducts() (FutureProductsResponse, error) {
+	return api.ListFutureProductsCtx(context.Background())
+}
+
+// ListFutureProductsCtx is like ListFutureProducts but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListFutureProductsCtx(ctx context.Context) (FutureProductsResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/future-products", api.host)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureProductsResponse{}, err
 	}
@@ -358,8 +374,13 @@ func (api *TastytradeAPI) ListFutureProducts() (FutureProductsResponse, error) {
 // Returns a FutureProductResponse containing detailed product configuration including
 // roll settings, clearing codes, and trading parameters.
 func (api *TastytradeAPI) GetFutureProduct(exchange string, symbol string) (FutureProductResponse, error) {
+	return api.GetFutureProductCtx(context.Background(), exchange, symbol)
+}
+
+// GetFutureProductCtx is like GetFutureProduct but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetFutureProductCtx(ctx context.Context, exchange string, symbol string) (FutureProductResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/future-products/%s/%s", api.host, exchange, url.PathEscape(symbol))
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureProductResponse{}, err
 	}
@@ -382,8 +403,13 @@ func (api *TastytradeAPI) GetFutureProduct(exchange string, symbol string) (Futu
 // Returns a FutureOptionChainsNestedResponse containing a hierarchical structure organized
 // by expiration dates and strikes, making it easier to navigate the option chain.
 func (api *TastytradeAPI) ListFutureOptionChainsNested(symbol string) (FutureOptionChainsNestedResponse, error) {
+	return api.ListFutureOptionChainsNestedCtx(context.Background(), symbol)
+}
+
+// ListFutureOptionChainsNestedCtx is like ListFutureOptionChainsNested but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListFutureOptionChainsNestedCtx(ctx context.Context, symbol string) (FutureOptionChainsNestedResponse, error) {
 	urlVal := fmt.Sprintf("%s/futures-option-chains/%s/nested", api.host, symbol)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureOptionChainsNestedResponse{}, err
 	}
@@ -406,8 +432,13 @@ func (api *TastytradeAPI) ListFutureOptionChainsNested(symbol string) (FutureOpt
 // Returns a FutureOptionChainsDetailedResponse containing a flat list of all future option
 // contracts in the chain with comprehensive details for each contract.
 func (api *TastytradeAPI) ListFutureOptionChainsDetailed(symbol string) (FutureOptionChainsDetailedResponse, error) {
+	return api.ListFutureOptionChainsDetailedCtx(context.Background(), symbol)
+}
+
+// ListFutureOptionChainsDetailedCtx is like ListFutureOptionChainsDetailed but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListFutureOptionChainsDetailedCtx(ctx context.Context, symbol string) (FutureOptionChainsDetailedResponse, error) {
 	urlVal := fmt.Sprintf("%s/futures-option-chains/%s", api.host, symbol)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureOptionChainsDetailedResponse{}, err
 	}
@@ -431,6 +462,11 @@ func (api *TastytradeAPI) ListFutureOptionChainsDetailed(symbol string) (FutureO
 // expiration date, option type, and strike price.
 // Returns a FutureOptionsDetailedResponse containing matching future option contracts.
 func (api *TastytradeAPI) ListFutureOptions(params *FutureOptionsQueryParams) (FutureOptionsDetailedResponse, error) {
+	return api.ListFutureOptionsCtx(context.Background(), params)
+}
+
+// ListFutureOptionsCtx is like ListFutureOptions but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListFutureOptionsCtx(ctx context.Context, params *FutureOptionsQueryParams) (FutureOptionsDetailedResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/future-options", api.host)
 
 	if params != nil {
@@ -453,7 +489,7 @@ func (api *TastytradeAPI) ListFutureOptions(params *FutureOptionsQueryParams) (F
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureOptionsDetailedResponse{}, err
 	}
@@ -476,8 +512,13 @@ func (api *TastytradeAPI) ListFutureOptions(params *FutureOptionsQueryParams) (F
 // Returns a FutureOptionDetailedResponse containing detailed information about the future option
 // contract including strike, expiration, exercise style, and settlement details.
 func (api *TastytradeAPI) GetFutureOption(symbol string) (FutureOptionDetailedResponse, error) {
+	return api.GetFutureOptionCtx(context.Background(), symbol)
+}
+
+// GetFutureOptionCtx is like GetFutureOption but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetFutureOptionCtx(ctx context.Context, symbol string) (FutureOptionDetailedResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/future-options/%s", api.host, url.PathEscape(symbol))
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureOptionDetailedResponse{}, err
 	}
@@ -500,8 +541,13 @@ func (api *TastytradeAPI) GetFutureOption(symbol string) (FutureOptionDetailedRe
 // Returns a FutureOptionProductsResponse containing all available future option products
 // with their configuration and trading parameters.
 func (api *TastytradeAPI) ListFutureOptionProducts() (FutureOptionProductsResponse, error) {
+	return api.ListFutureOptionProductsCtx(context.Background())
+}
+
+// ListFutureOptionProductsCtx is like ListFutureOptionProducts but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListFutureOptionProductsCtx(ctx context.Context) (FutureOptionProductsResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/future-option-products", api.host)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureOptionProductsResponse{}, err
 	}
@@ -524,8 +570,13 @@ func (api *TastytradeAPI) ListFutureOptionProducts() (FutureOptionProductsRespon
 // Returns a FutureOptionProductDetailedResponse containing detailed product configuration
 // including clearing codes, settlement parameters, and expiration settings.
 func (api *TastytradeAPI) GetFutureOptionProduct(exchange string, rootSymbol string) (FutureOptionProductDetailedResponse, error) {
+	return api.GetFutureOptionProductCtx(context.Background(), exchange, rootSymbol)
+}
+
+// GetFutureOptionProductCtx is like GetFutureOptionProduct but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetFutureOptionProductCtx(ctx context.Context, exchange string, rootSymbol string) (FutureOptionProductDetailedResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/future-option-products/%s/%s", api.host, exchange, rootSymbol)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureOptionProductDetailedResponse{}, err
 	}
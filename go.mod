@@ -1,3 +1,5 @@
 module github.com/optionsvamp/tastytrade
 
 go 1.22.3
+
+require github.com/gorilla/websocket v1.5.3
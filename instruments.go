@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -57,9 +58,14 @@ type FuturesQueryParamsV2 struct {
 // GetQuantityDecimalPrecisions retrieves all quantity decimal precisions for instruments.
 // Returns a QuantityDecimalPrecisionsResponse containing precision configurations for all instrument types.
 func (api *TastytradeAPI) GetQuantityDecimalPrecisions() (QuantityDecimalPrecisionsResponse, error) {
+	return api.GetQuantityDecimalPrecisionsCtx(context.Background())
+}
+
+// GetQuantityDecimalPrecisionsCtx is like GetQuantityDecimalPrecisions but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetQuantityDecimalPrecisionsCtx(ctx context.Context) (QuantityDecimalPrecisionsResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/quantity-decimal-precisions", api.host)
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return QuantityDecimalPrecisionsResponse{}, err
 	}
@@ -129,6 +135,11 @@ func (api *TastytradeAPI) GetQuantityDecimalPrecisions() (QuantityDecimalPrecisi
 // params can be nil to retrieve all cryptocurrencies, or can filter by symbol array.
 // Returns a CryptocurrenciesListResponse containing matching cryptocurrency instruments.
 func (api *TastytradeAPI) ListCryptocurrenciesWithParams(params *CryptocurrenciesQueryParams) (CryptocurrenciesListResponse, error) {
+	return api.ListCryptocurrenciesWithParamsCtx(context.Background(), params)
+}
+
+// ListCryptocurrenciesWithParamsCtx is like ListCryptocurrenciesWithParams but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListCryptocurrenciesWithParamsCtx(ctx context.Context, params *CryptocurrenciesQueryParams) (CryptocurrenciesListResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/cryptocurrencies", api.host)
 
 	if params != nil && len(params.Symbol) > 0 {
@@ -139,7 +150,7 @@ func (api *TastytradeAPI) ListCryptocurrenciesWithParams(params *Cryptocurrencie
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +194,11 @@ func (api *TastytradeAPI) ListCryptocurrenciesWithParams(params *Cryptocurrencie
 // active is optional and filters for options available for trading (defaults to filtering non-standard/flex options).
 // Returns an EquityOptionResponse containing detailed information about the equity option.
 func (api *TastytradeAPI) GetEquityOptionWithActive(symbol string, active *bool) (EquityOptionResponse, error) {
+	return api.GetEquityOptionWithActiveCtx(context.Background(), symbol, active)
+}
+
+// GetEquityOptionWithActiveCtx is like GetEquityOptionWithActive but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetEquityOptionWithActiveCtx(ctx context.Context, symbol string, active *bool) (EquityOptionResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/equity-options/%s", api.host, url.PathEscape(symbol))
 
 	if active != nil {
@@ -191,7 +207,7 @@ func (api *TastytradeAPI) GetEquityOptionWithActive(symbol string, active *bool)
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return EquityOptionResponse{}, err
 	}
@@ -214,6 +230,11 @@ func (api *TastytradeAPI) GetEquityOptionWithActive(symbol string, active *bool)
 // params can be nil to use defaults, or can specify page-offset and per-page.
 // Returns a FutureOptionProductsResponse containing a list of future option products.
 func (api *TastytradeAPI) ListFutureOptionProductsWithPagination(params *FutureOptionProductsQueryParams) (FutureOptionProductsResponse, error) {
+	return api.ListFutureOptionProductsWithPaginationCtx(context.Background(), params)
+}
+
+// ListFutureOptionProductsWithPaginationCtx is like ListFutureOptionProductsWithPagination but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListFutureOptionProductsWithPaginationCtx(ctx context.Context, params *FutureOptionProductsQueryParams) (FutureOptionProductsResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/future-option-products", api.host)
 
 	if params != nil {
@@ -229,7 +250,7 @@ func (api *TastytradeAPI) ListFutureOptionProductsWithPagination(params *FutureO
 		}
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureOptionProductsResponse{}, err
 	}
@@ -278,6 +299,11 @@ func (api *TastytradeAPI) ListFutureOptionProductsWithPagination(params *FutureO
 // params can be nil to use defaults, or can specify page-offset and per-page.
 // Returns a FutureProductsResponse containing a list of future products.
 func (api *TastytradeAPI) ListFutureProductsWithPagination(params *FutureProductsQueryParams) (FutureProductsResponse, error) {
+	return api.ListFutureProductsWithPaginationCtx(context.Background(), params)
+}
+
+// ListFutureProductsWithPaginationCtx is like ListFutureProductsWithPagination but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListFutureProductsWithPaginationCtx(ctx context.Context, params *FutureProductsQueryParams) (FutureProductsResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/future-products", api.host)
 
 	if params != nil {
@@ -293,7 +319,7 @@ func (api *TastytradeAPI) ListFutureProductsWithPagination(params *FutureProduct
 		}
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureProductsResponse{}, err
 	}
@@ -343,6 +369,11 @@ func (api *TastytradeAPI) ListFutureProductsWithPagination(params *FutureProduct
 // exchange, and only-active-futures flag.
 // Returns a FuturesQueryResponse containing a list of matching future contracts.
 func (api *TastytradeAPI) QueryFuturesV2(params *FuturesQueryParamsV2) (FuturesQueryResponse, error) {
+	return api.QueryFuturesV2Ctx(context.Background(), params)
+}
+
+// QueryFuturesV2Ctx is like QueryFuturesV2 but carries ctx on the outgoing request.
+func (api *TastytradeAPI) QueryFuturesV2Ctx(ctx context.Context, params *FuturesQueryParamsV2) (FuturesQueryResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/futures", api.host)
 
 	if params != nil {
@@ -373,7 +404,7 @@ func (api *TastytradeAPI) QueryFuturesV2(params *FuturesQueryParamsV2) (FuturesQ
 		}
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FuturesQueryResponse{}, err
 	}
@@ -396,9 +427,14 @@ func (api *TastytradeAPI) QueryFuturesV2(params *FuturesQueryParamsV2) (FuturesQ
 // symbolID is the integer ID of the underlying symbol (not the symbol string).
 // Returns an OptionChainsDetailedResponse containing all option contracts in the chain.
 func (api *TastytradeAPI) GetOptionChainBySymbolID(symbolID int) (OptionChainsDetailedResponse, error) {
+	return api.GetOptionChainBySymbolIDCtx(context.Background(), symbolID)
+}
+
+// GetOptionChainBySymbolIDCtx is like GetOptionChainBySymbolID but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetOptionChainBySymbolIDCtx(ctx context.Context, symbolID int) (OptionChainsDetailedResponse, error) {
 	urlVal := fmt.Sprintf("%s/option-chains/%d", api.host, symbolID)
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return OptionChainsDetailedResponse{}, err
 	}
@@ -421,9 +457,14 @@ func (api *TastytradeAPI) GetOptionChainBySymbolID(symbolID int) (OptionChainsDe
 // symbolID is the integer ID of the futures product code (not the symbol string).
 // Returns a FutureOptionChainsNestedResponse containing the futures option chain.
 func (api *TastytradeAPI) GetFuturesOptionChainBySymbolID(symbolID int) (FutureOptionChainsNestedResponse, error) {
+	return api.GetFuturesOptionChainBySymbolIDCtx(context.Background(), symbolID)
+}
+
+// GetFuturesOptionChainBySymbolIDCtx is like GetFuturesOptionChainBySymbolID but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetFuturesOptionChainBySymbolIDCtx(ctx context.Context, symbolID int) (FutureOptionChainsNestedResponse, error) {
 	urlVal := fmt.Sprintf("%s/futures-option-chains/%d", api.host, symbolID)
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return FutureOptionChainsNestedResponse{}, err
 	}
@@ -1,10 +1,12 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
+	"sync"
 )
 
 // QuoteData represents market data quote information for any security type.
@@ -89,6 +91,11 @@ type QuoteQueryParams struct {
 //
 // Returns a QuotesResponse containing quote data for all requested securities.
 func (api *TastytradeAPI) GetQuotesByType(params *QuoteQueryParams) (QuotesResponse, error) {
+	return api.GetQuotesByTypeCtx(context.Background(), params)
+}
+
+// GetQuotesByTypeCtx is like GetQuotesByType but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetQuotesByTypeCtx(ctx context.Context, params *QuoteQueryParams) (QuotesResponse, error) {
 	urlVal := fmt.Sprintf("%s/market-data/by-type", api.host)
 
 	if params != nil {
@@ -116,7 +123,7 @@ func (api *TastytradeAPI) GetQuotesByType(params *QuoteQueryParams) (QuotesRespo
 		}
 	}
 
-	data, err := api.fetchData(urlVal)
+	data, err := api.fetchData(ctx, urlVal)
 	if err != nil {
 		return QuotesResponse{}, err
 	}
@@ -135,6 +142,166 @@ func (api *TastytradeAPI) GetQuotesByType(params *QuoteQueryParams) (QuotesRespo
 	return response, nil
 }
 
+const (
+	// maxQuoteSymbols is the most symbols the server accepts in a single market-data/by-type request.
+	maxQuoteSymbols = 100
+	// defaultQuoteConcurrency is the number of GetQuotes batches requested in parallel by default.
+	defaultQuoteConcurrency = 4
+)
+
+// GetQuotesOptions controls how GetQuotes splits and parallelizes a request.
+type GetQuotesOptions struct {
+	BatchSize   int // Symbols per request across all instrument types (default and maximum: 100)
+	Concurrency int // Maximum number of requests in flight (default: 4)
+}
+
+// QuoteBatchError reports a GetQuotes batch that failed.
+type QuoteBatchError struct {
+	Params QuoteQueryParams // Symbols requested by the failed batch
+	Err    error            // Error returned for the batch
+}
+
+// Error implements the error interface.
+func (e *QuoteBatchError) Error() string {
+	count := 0
+	for _, symbols := range quoteParamFields(&e.Params) {
+		count += len(*symbols)
+	}
+	return fmt.Sprintf("quote batch of %d symbols failed: %v", count, e.Err)
+}
+
+// Unwrap returns the error of the batch request.
+func (e *QuoteBatchError) Unwrap() error {
+	return e.Err
+}
+
+// QuotesError is returned by GetQuotes when some batches failed. The quotes of the other batches are still returned.
+type QuotesError struct {
+	Failures []*QuoteBatchError // Failed batches in request order
+	Batches  int                // Total number of batches requested
+}
+
+// Error implements the error interface.
+func (e *QuotesError) Error() string {
+	return fmt.Sprintf("%d of %d quote batches failed: %v", len(e.Failures), e.Batches, e.Failures[0])
+}
+
+// Unwrap returns the errors of the failed batches.
+func (e *QuotesError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		errs = append(errs, failure)
+	}
+	return errs
+}
+
+// GetQuotes fetches quotes for any number of symbols across instrument types. The symbols are split into
+// batches within the server's limit of 100 per request, which run in parallel under the client's rate limiter.
+// opts may be nil to use the defaults.
+//
+// Returns a QuotesResponse with the quotes of all batches in request order. When some batches fail, the quotes
+// of the others are returned together with a *QuotesError listing the failed batches.
+func (api *TastytradeAPI) GetQuotes(params *QuoteQueryParams, opts *GetQuotesOptions) (QuotesResponse, error) {
+	return api.GetQuotesCtx(context.Background(), params, opts)
+}
+
+// GetQuotesCtx is like GetQuotes but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetQuotesCtx(ctx context.Context, params *QuoteQueryParams, opts *GetQuotesOptions) (QuotesResponse, error) {
+	batchSize, concurrency := maxQuoteSymbols, defaultQuoteConcurrency
+	if opts != nil {
+		if opts.BatchSize > 0 {
+			batchSize = opts.BatchSize
+		}
+		if opts.Concurrency > 0 {
+			concurrency = opts.Concurrency
+		}
+	}
+
+	batches := quoteBatches(params, batchSize)
+	results := make([][]QuoteData, len(batches))
+	errs := make([]error, len(batches))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for i, batch := range batches {
+		wg.Add(1)
+		go func(i int, batch *QuoteQueryParams) {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			defer func() { <-semaphore }()
+
+			response, err := api.GetQuotesByTypeCtx(ctx, batch)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = response.Data.Items
+		}(i, batch)
+	}
+	wg.Wait()
+
+	var response QuotesResponse
+	quotesErr := &QuotesError{Batches: len(batches)}
+	for i, items := range results {
+		if errs[i] != nil {
+			quotesErr.Failures = append(quotesErr.Failures, &QuoteBatchError{Params: *batches[i], Err: errs[i]})
+			continue
+		}
+		response.Data.Items = append(response.Data.Items, items...)
+	}
+	if len(quotesErr.Failures) > 0 {
+		return response, quotesErr
+	}
+	return response, nil
+}
+
+// quoteParamFields returns pointers to the symbol lists of params in a fixed order.
+func quoteParamFields(params *QuoteQueryParams) []*[]string {
+	return []*[]string{
+		&params.Cryptocurrency,
+		&params.Equity,
+		&params.EquityOption,
+		&params.Index,
+		&params.Future,
+		&params.FutureOption,
+	}
+}
+
+// quoteBatches splits params into requests of at most size symbols in total, keeping each symbol under its type.
+func quoteBatches(params *QuoteQueryParams, size int) []*QuoteQueryParams {
+	if params == nil {
+		return nil
+	}
+	if size <= 0 || size > maxQuoteSymbols {
+		size = maxQuoteSymbols
+	}
+
+	var batches []*QuoteQueryParams
+	batch := &QuoteQueryParams{}
+	count := 0
+	for i, symbols := range quoteParamFields(params) {
+		for _, symbol := range *symbols {
+			if count == size {
+				batches = append(batches, batch)
+				batch = &QuoteQueryParams{}
+				count = 0
+			}
+			field := quoteParamFields(batch)[i]
+			*field = append(*field, symbol)
+			count++
+		}
+	}
+	if count > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
 // OptionExpirationImpliedVolatility represents implied volatility data for a specific option expiration.
 type OptionExpirationImpliedVolatility struct {
 	ExpirationDate    string  `json:"expiration-date"`    // Option expiration date (date-time format)
@@ -174,6 +341,11 @@ type EarningsInfo struct {
 // Returns an array of MarketMetricInfo containing volatility and liquidity data
 // for each symbol, including option expiration implied volatilities.
 func (api *TastytradeAPI) GetMarketMetrics(symbols []string) ([]MarketMetricInfo, error) {
+	return api.GetMarketMetricsCtx(context.Background(), symbols)
+}
+
+// GetMarketMetricsCtx is like GetMarketMetrics but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetMarketMetricsCtx(ctx context.Context, symbols []string) ([]MarketMetricInfo, error) {
 	urlVal := fmt.Sprintf("%s/market-metrics", api.host)
 
 	if len(symbols) > 0 {
@@ -182,7 +354,7 @@ func (api *TastytradeAPI) GetMarketMetrics(symbols []string) ([]MarketMetricInfo
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchData(urlVal)
+	data, err := api.fetchData(ctx, urlVal)
 	if err != nil {
 		return nil, err
 	}
@@ -236,9 +408,14 @@ func (api *TastytradeAPI) GetMarketMetrics(symbols []string) ([]MarketMetricInfo
 // GetHistoricalDividends retrieves historical dividend data for a symbol.
 // Returns an array of DividendInfo containing dividend dates and amounts.
 func (api *TastytradeAPI) GetHistoricalDividends(symbol string) ([]DividendInfo, error) {
+	return api.GetHistoricalDividendsCtx(context.Background(), symbol)
+}
+
+// GetHistoricalDividendsCtx is like GetHistoricalDividends but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetHistoricalDividendsCtx(ctx context.Context, symbol string) ([]DividendInfo, error) {
 	urlVal := fmt.Sprintf("%s/market-metrics/historic-corporate-events/dividends/%s", api.host, url.PathEscape(symbol))
 
-	data, err := api.fetchData(urlVal)
+	data, err := api.fetchData(ctx, urlVal)
 	if err != nil {
 		return nil, err
 	}
@@ -289,6 +466,11 @@ func (api *TastytradeAPI) GetHistoricalDividends(symbol string) ([]DividendInfo,
 // Date format should be YYYY-MM-DD.
 // Returns an array of EarningsInfo containing earnings announcement dates and EPS amounts.
 func (api *TastytradeAPI) GetHistoricalEarnings(symbol string, startDate string, endDate *string) ([]EarningsInfo, error) {
+	return api.GetHistoricalEarningsCtx(context.Background(), symbol, startDate, endDate)
+}
+
+// GetHistoricalEarningsCtx is like GetHistoricalEarnings but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetHistoricalEarningsCtx(ctx context.Context, symbol string, startDate string, endDate *string) ([]EarningsInfo, error) {
 	urlVal := fmt.Sprintf("%s/market-metrics/historic-corporate-events/earnings-reports/%s", api.host, url.PathEscape(symbol))
 
 	queryParams := url.Values{}
@@ -298,7 +480,7 @@ func (api *TastytradeAPI) GetHistoricalEarnings(symbol string, startDate string,
 	}
 	urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 
-	data, err := api.fetchData(urlVal)
+	data, err := api.fetchData(ctx, urlVal)
 	if err != nil {
 		return nil, err
 	}
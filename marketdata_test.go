@@ -0,0 +1,97 @@
+package tastytrade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetQuotes(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			max := maxInFlight.Load()
+			if n <= max || maxInFlight.CompareAndSwap(max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		symbols := strings.Split(req.URL.Query().Get("equity"), ",")
+		if len(symbols) > 10 {
+			t.Errorf("expected at most %d symbols, got %d", 10, len(symbols))
+		}
+		if symbols[0] == "EQ20" {
+			rw.WriteHeader(http.StatusInternalServerError)
+			rw.Write([]byte(`{"error": {"code": "internal_error", "message": "boom"}}`))
+			return
+		}
+
+		var items []QuoteData
+		for _, symbol := range symbols {
+			items = append(items, QuoteData{Symbol: symbol})
+		}
+		json.NewEncoder(rw).Encode(map[string]interface{}{"data": map[string]interface{}{"items": items}})
+	}))
+	defer server.Close()
+
+	equities := make([]string, 45)
+	for i := range equities {
+		equities[i] = fmt.Sprintf("EQ%d", i)
+	}
+
+	api := NewTastytradeAPI(server.URL)
+	resp, err := api.GetQuotesCtx(context.Background(), &QuoteQueryParams{Equity: equities}, &GetQuotesOptions{BatchSize: 10, Concurrency: 2})
+
+	var quotesErr *QuotesError
+	if !errors.As(err, &quotesErr) {
+		t.Fatalf("expected *QuotesError, got %v", err)
+	}
+
+	if quotesErr.Batches != 5 || len(quotesErr.Failures) != 1 {
+		t.Errorf("expected 1 of 5 batches to fail, got %d of %d", len(quotesErr.Failures), quotesErr.Batches)
+	}
+
+	if failed := quotesErr.Failures[0].Params.Equity; len(failed) != 10 || failed[0] != "EQ20" {
+		t.Errorf("unexpected failed batch %v", failed)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("expected wrapped *APIError, got %v", err)
+	}
+
+	if len(resp.Data.Items) != 35 {
+		t.Fatalf("expected %d, got %d", 35, len(resp.Data.Items))
+	}
+
+	if resp.Data.Items[0].Symbol != "EQ0" || resp.Data.Items[34].Symbol != "EQ44" {
+		t.Errorf("expected quotes in request order, got %s and %s", resp.Data.Items[0].Symbol, resp.Data.Items[34].Symbol)
+	}
+
+	if maxInFlight.Load() > 2 {
+		t.Errorf("expected at most %d requests in flight, got %d", 2, maxInFlight.Load())
+	}
+}
+
+func TestQuoteBatches(t *testing.T) {
+	equities := make([]string, 150)
+	batches := quoteBatches(&QuoteQueryParams{Equity: equities, Future: []string{"/ESZ4", "/CLZ4"}}, 100)
+
+	if len(batches) != 2 {
+		t.Fatalf("expected %d, got %d", 2, len(batches))
+	}
+
+	if len(batches[0].Equity) != 100 || len(batches[1].Equity) != 50 || len(batches[1].Future) != 2 {
+		t.Errorf("unexpected batches %d/%d/%d", len(batches[0].Equity), len(batches[1].Equity), len(batches[1].Future))
+	}
+}
@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -181,8 +182,13 @@ type EquityOptionResponse struct {
 // Returns an OptionChainsDetailedResponse containing a flat list of all option contracts
 // in the chain with comprehensive details for each contract.
 func (api *TastytradeAPI) ListOptionsChainsDetailed(symbol string) (OptionChainsDetailedResponse, error) {
+	return api.ListOptionsChainsDetailedCtx(context.Background(), symbol)
+}
+
+// ListOptionsChainsDetailedCtx is like ListOptionsChainsDetailed but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListOptionsChainsDetailedCtx(ctx context.Context, symbol string) (OptionChainsDetailedResponse, error) {
 	urlVal := fmt.Sprintf("%s/option-chains/%s", api.host, symbol)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return OptionChainsDetailedResponse{}, err
 	}
@@ -205,8 +211,13 @@ func (api *TastytradeAPI) ListOptionsChainsDetailed(symbol string) (OptionChains
 // Returns an OptionChainsNestedResponse containing a hierarchical structure organized
 // by expiration dates and strike prices, making it easier to navigate the chain.
 func (api *TastytradeAPI) ListOptionChainsNested(symbol string) (OptionChainsNestedResponse, error) {
+	return api.ListOptionChainsNestedCtx(context.Background(), symbol)
+}
+
+// ListOptionChainsNestedCtx is like ListOptionChainsNested but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListOptionChainsNestedCtx(ctx context.Context, symbol string) (OptionChainsNestedResponse, error) {
 	urlVal := fmt.Sprintf("%s/option-chains/%s/nested", api.host, symbol)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return OptionChainsNestedResponse{}, err
 	}
@@ -229,8 +240,13 @@ func (api *TastytradeAPI) ListOptionChainsNested(symbol string) (OptionChainsNes
 // Returns an OptionChainsCompactResponse containing a minimal representation with
 // deliverables and option symbols, useful for quick lookups.
 func (api *TastytradeAPI) GetOptionChainsCompact(symbol string) (OptionChainsCompactResponse, error) {
+	return api.GetOptionChainsCompactCtx(context.Background(), symbol)
+}
+
+// GetOptionChainsCompactCtx is like GetOptionChainsCompact but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetOptionChainsCompactCtx(ctx context.Context, symbol string) (OptionChainsCompactResponse, error) {
 	urlVal := fmt.Sprintf("%s/option-chains/%s/compact", api.host, symbol)
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return OptionChainsCompactResponse{}, err
 	}
@@ -254,6 +270,11 @@ func (api *TastytradeAPI) GetOptionChainsCompact(symbol string) (OptionChainsCom
 // active status, and whether to include expired options.
 // Returns an EquityOptionsListResponse containing matching equity option instruments.
 func (api *TastytradeAPI) GetEquityOptions(params *EquityOptionsQueryParams) (EquityOptionsListResponse, error) {
+	return api.GetEquityOptionsCtx(context.Background(), params)
+}
+
+// GetEquityOptionsCtx is like GetEquityOptions but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetEquityOptionsCtx(ctx context.Context, params *EquityOptionsQueryParams) (EquityOptionsListResponse, error) {
 	urlVal := fmt.Sprintf("%s/instruments/equity-options", api.host)
 
 	if params != nil {
@@ -270,7 +291,7 @@ func (api *TastytradeAPI) GetEquityOptions(params *EquityOptionsQueryParams) (Eq
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchInstrumentData(urlVal)
+	data, err := api.fetchInstrumentData(ctx, urlVal)
 	if err != nil {
 		return EquityOptionsListResponse{}, err
 	}
@@ -293,8 +314,13 @@ func (api *TastytradeAPI) GetEquityOptions(params *EquityOptionsQueryParams) (Eq
 // Returns an EquityOptionResponse containing detailed information about the option
 // contract including strike, expiration, exercise style, and settlement details.
 func (api *TastytradeAPI) GetEquityOption(symbol string) (EquityOptionResponse, error) {
+	return api.GetEquityOptionCtx(context.Background(), symbol)
+}
+
+// GetEquityOptionCtx is like GetEquityOption but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetEquityOptionCtx(ctx context.Context, symbol string) (EquityOptionResponse, error) {
 	url := fmt.Sprintf("%s/instruments/equity-options/%s", api.host, url.PathEscape(symbol))
-	data, err := api.fetchInstrumentData(url)
+	data, err := api.fetchInstrumentData(ctx, url)
 	if err != nil {
 		return EquityOptionResponse{}, err
 	}
@@ -0,0 +1,175 @@
+package tastytrade
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultTimeout is the HTTP client timeout used when no client or timeout is configured.
+	defaultTimeout = 10 * time.Second
+)
+
+// Option configures a TastytradeAPI created with New.
+type Option func(*TastytradeAPI)
+
+// New creates a new TastytradeAPI configured by the given options.
+// Without options the client talks to the production environment with a 10 second timeout.
+//
+// Example usage:
+//
+//	api := tastytrade.New(
+//	    tastytrade.WithEnvironment(tastytrade.Sandbox),
+//	    tastytrade.WithAPIVersion("20250715"),
+//	    tastytrade.WithRetryPolicy(tastytrade.DefaultRetryPolicy()),
+//	)
+func New(opts ...Option) *TastytradeAPI {
+	api := &TastytradeAPI{
+		httpClient:  &http.Client{Timeout: defaultTimeout},
+		environment: Production,
+	}
+	for _, opt := range opts {
+		opt(api)
+	}
+	if api.host == "" {
+		// Resolved after all options so WithHost wins regardless of its position relative to WithEnvironment
+		api.host = api.environment.APIURL
+	}
+	if oauth, ok := api.authenticator.(*OAuth2Authenticator); ok {
+		// Bind an OAuth2 authenticator created by WithOAuth2 to the final host and HTTP client
+		if oauth.TokenURL == "" {
+			oauth.TokenURL = api.host + "/oauth/token"
+		}
+		if oauth.HTTPClient == nil {
+			oauth.HTTPClient = api.httpClient
+		}
+	}
+	return api
+}
+
+// WithHTTPClient sets the HTTP client used for all requests.
+// Options that adjust the client (WithTimeout, WithTransport) applied afterwards operate on a copy.
+func WithHTTPClient(client *http.Client) Option {
+	return func(api *TastytradeAPI) {
+		if client != nil {
+			api.httpClient = client
+		}
+	}
+}
+
+// WithTransport sets the RoundTripper used by the HTTP client, e.g. for proxies or instrumentation.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(api *TastytradeAPI) {
+		client := *api.httpClient
+		client.Transport = transport
+		api.httpClient = &client
+	}
+}
+
+// WithTimeout sets the overall timeout of each HTTP request. Zero disables the timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(api *TastytradeAPI) {
+		client := *api.httpClient
+		client.Timeout = timeout
+		api.httpClient = &client
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(api *TastytradeAPI) {
+		api.userAgent = userAgent
+	}
+}
+
+// WithAPIVersion sets the API version sent in the Accept-Version header (e.g., "20250715").
+func WithAPIVersion(version string) Option {
+	return func(api *TastytradeAPI) {
+		api.apiVersion = version
+	}
+}
+
+// WithEnvironment selects the environment the client talks to (Production or Sandbox).
+// The REST host follows the environment unless WithHost is also given.
+func WithEnvironment(env Environment) Option {
+	return func(api *TastytradeAPI) {
+		api.environment = env
+	}
+}
+
+// WithProductionWrites allows state-mutating requests (anything sent as POST through the
+// shared request layer, such as SubmitBacktest) against the production environment.
+// They are refused by default so code developed against the Sandbox cannot trade live by accident.
+func WithProductionWrites(allow bool) Option {
+	return func(api *TastytradeAPI) {
+		api.productionWrites = allow
+	}
+}
+
+// WithHost overrides the REST API host, e.g. to point the client at a test server.
+// It takes precedence over WithEnvironment in either order. The environment (and therefore
+// the production write guard) is left unchanged.
+func WithHost(host string) Option {
+	return func(api *TastytradeAPI) {
+		api.host = host
+	}
+}
+
+// WithLogger sets the logger used to report retries and failed requests.
+// By default the client does not log.
+func WithLogger(logger *slog.Logger) Option {
+	return func(api *TastytradeAPI) {
+		api.logger = logger
+	}
+}
+
+// WithSessionStore sets the store used to persist sessions across runs.
+// Successful logins are saved automatically; ResumeSession restores them.
+func WithSessionStore(store SessionStore) Option {
+	return func(api *TastytradeAPI) {
+		api.sessionStore = store
+	}
+}
+
+// WithAutoReauthenticate makes the client log in again when its session expires and retry the
+// failed request once. The remember token of the session is used when available; otherwise the
+// password passed to Authenticate is kept in memory for this purpose.
+func WithAutoReauthenticate() Option {
+	return func(api *TastytradeAPI) {
+		api.autoReauth = true
+	}
+}
+
+// WithAuthenticator sets the authenticator supplying credentials for every request,
+// replacing the session token obtained by Authenticate.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(api *TastytradeAPI) {
+		api.authenticator = authenticator
+	}
+}
+
+// WithOAuth2 authenticates requests with OAuth2 access tokens obtained from the environment's
+// /oauth/token endpoint using the given client secret and refresh token.
+func WithOAuth2(clientSecret, refreshToken string) Option {
+	return func(api *TastytradeAPI) {
+		api.authenticator = &OAuth2Authenticator{
+			ClientSecret: clientSecret,
+			RefreshToken: refreshToken,
+		}
+	}
+}
+
+// WithRetryPolicy sets the retry policy for failed requests. See SetRetryPolicy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(api *TastytradeAPI) {
+		api.retryPolicy = policy
+	}
+}
+
+// WithRateLimiter sets the client-side rate limiter. See SetRateLimiter.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(api *TastytradeAPI) {
+		api.rateLimiter = limiter
+	}
+}
@@ -0,0 +1,86 @@
+package tastytrade
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, req)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewDefaults(t *testing.T) {
+	api := New()
+
+	if api.host != baseURL {
+		t.Errorf("expected %s, got %s", baseURL, api.host)
+	}
+
+	if api.httpClient.Timeout != defaultTimeout {
+		t.Errorf("expected %v, got %v", defaultTimeout, api.httpClient.Timeout)
+	}
+
+	if api.environment.Name != Production.Name {
+		t.Errorf("expected %s, got %s", Production.Name, api.environment.Name)
+	}
+}
+
+func TestNewWithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("User-Agent") != "test-agent/1.0" {
+			t.Errorf("expected %s, got %s", "test-agent/1.0", req.Header.Get("User-Agent"))
+		}
+		if req.Header.Get("Accept-Version") != "20250715" {
+			t.Errorf("expected %s, got %s", "20250715", req.Header.Get("Accept-Version"))
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"symbol": "AAPL"}}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{}
+	transport := &recordingTransport{}
+	api := New(
+		WithHTTPClient(client),
+		WithTransport(transport),
+		WithTimeout(time.Second),
+		WithHost(server.URL),
+		WithUserAgent("test-agent/1.0"),
+		WithAPIVersion("20250715"),
+	)
+
+	if client.Timeout != 0 || client.Transport != nil {
+		t.Errorf("expected the caller's client to be left untouched")
+	}
+
+	if api.httpClient.Timeout != time.Second {
+		t.Errorf("expected %v, got %v", time.Second, api.httpClient.Timeout)
+	}
+
+	resp, err := api.GetEquityData("AAPL")
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Symbol != "AAPL" {
+		t.Errorf("expected %s, got %s", "AAPL", resp.Data.Symbol)
+	}
+
+	if len(transport.requests) != 1 {
+		t.Errorf("expected %d, got %d", 1, len(transport.requests))
+	}
+}
+
+func TestNewWithEnvironment(t *testing.T) {
+	api := New(WithEnvironment(Sandbox))
+
+	if api.host != Sandbox.APIURL {
+		t.Errorf("expected %s, got %s", Sandbox.APIURL, api.host)
+	}
+}
@@ -0,0 +1,274 @@
+package tastytrade
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Option types accepted by OrderBuilder, matching OptionDataDetailed.OptionType.
+const (
+	OptionTypeCall = "C" // Call option
+	OptionTypePut  = "P" // Put option
+)
+
+// builderLeg is a leg added to an OrderBuilder together with its optional per-contract price.
+type builderLeg struct {
+	OrderLeg
+	contracts int     // Number of contracts, as passed to the leg methods
+	price     Decimal // Price per contract set with At
+	priceSet  bool    // Whether At was called for this leg
+}
+
+// OrderBuilder assembles multi-leg equity option orders from a nested option chain.
+// Legs are looked up by option type and strike in a single expiration, and the resulting order
+// carries the OCC symbols, actions, net price and price effect expected by PlaceOrder.
+// Errors are collected while building and reported by Build, so calls can be chained:
+//
+//	order, err := tastytrade.NewOrderBuilder(chain, "2024-09-20").
+//		SellToOpen(tastytrade.OptionTypePut, 95, 1).At(1.20).
+//		BuyToOpen(tastytrade.OptionTypePut, 90, 1).At(0.45).
+//		Build()
+//
+// The order above is a 0.75 credit put spread with a Day time in force.
+type OrderBuilder struct {
+	chain          OptionChainsNestedResponse
+	expirationDate string
+	rootSymbol     string
+	legs           []builderLeg
+	timeInForce    string
+	gtcDate        string
+	netPrice       Decimal
+	netPriceSet    bool
+	err            error
+}
+
+// NewOrderBuilder creates an OrderBuilder for options expiring on expirationDate (YYYY-MM-DD format)
+// in the given option chain, as returned by ListOptionChainsNested.
+func NewOrderBuilder(chain OptionChainsNestedResponse, expirationDate string) *OrderBuilder {
+	return &OrderBuilder{
+		chain:          chain,
+		expirationDate: expirationDate,
+		timeInForce:    TimeInForceDay,
+	}
+}
+
+// Root restricts strike lookups to the chain with the given root symbol (e.g., "SPXW" rather than "SPX")
+// when several roots share the expiration date. It must be called before adding legs.
+func (b *OrderBuilder) Root(rootSymbol string) *OrderBuilder {
+	b.rootSymbol = rootSymbol
+	return b
+}
+
+// BuyToOpen adds a leg buying quantity contracts of the given option type and strike to open a position.
+func (b *OrderBuilder) BuyToOpen(optionType string, strike float64, quantity int) *OrderBuilder {
+	return b.Leg(OrderActionBuyToOpen, optionType, strike, quantity)
+}
+
+// SellToOpen adds a leg selling quantity contracts of the given option type and strike to open a position.
+func (b *OrderBuilder) SellToOpen(optionType string, strike float64, quantity int) *OrderBuilder {
+	return b.Leg(OrderActionSellToOpen, optionType, strike, quantity)
+}
+
+// BuyToClose adds a leg buying quantity contracts of the given option type and strike to close a short position.
+func (b *OrderBuilder) BuyToClose(optionType string, strike float64, quantity int) *OrderBuilder {
+	return b.Leg(OrderActionBuyToClose, optionType, strike, quantity)
+}
+
+// SellToClose adds a leg selling quantity contracts of the given option type and strike to close a long position.
+func (b *OrderBuilder) SellToClose(optionType string, strike float64, quantity int) *OrderBuilder {
+	return b.Leg(OrderActionSellToClose, optionType, strike, quantity)
+}
+
+// Leg adds a leg with the given action, option type ("C" or "P"), strike and quantity.
+func (b *OrderBuilder) Leg(action, optionType string, strike float64, quantity int) *OrderBuilder {
+	if b.err != nil {
+		return b
+	}
+	if quantity <= 0 {
+		b.err = fmt.Errorf("invalid quantity %d for %s %g%s", quantity, action, strike, optionType)
+		return b
+	}
+
+	symbol, err := b.lookup(optionType, strike)
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	b.legs = append(b.legs, builderLeg{OrderLeg: OrderLeg{
+		InstrumentType: "Equity Option",
+		Symbol:         symbol,
+		Quantity:       NewDecimal(int64(quantity), 0),
+		Action:         action,
+	}, contracts: quantity})
+	return b
+}
+
+// At sets the price per contract of the most recently added leg, typically its mid or mark price.
+// When every leg has a price, Build derives the net limit price and price effect from them.
+func (b *OrderBuilder) At(price float64) *OrderBuilder {
+	if b.err != nil {
+		return b
+	}
+	if len(b.legs) == 0 {
+		b.err = errors.New("At called before any leg was added")
+		return b
+	}
+	leg := &b.legs[len(b.legs)-1]
+	leg.price = NewDecimalFromFloat(price)
+	leg.priceSet = true
+	return b
+}
+
+// Vertical adds a vertical spread of the given option type: buying the buyStrike and selling the sellStrike.
+// Whether it is a debit or credit spread follows from the strikes.
+func (b *OrderBuilder) Vertical(optionType string, buyStrike, sellStrike float64, quantity int) *OrderBuilder {
+	return b.BuyToOpen(optionType, buyStrike, quantity).SellToOpen(optionType, sellStrike, quantity)
+}
+
+// ShortStrangle adds a short put at putStrike and a short call at callStrike.
+func (b *OrderBuilder) ShortStrangle(putStrike, callStrike float64, quantity int) *OrderBuilder {
+	return b.SellToOpen(OptionTypePut, putStrike, quantity).SellToOpen(OptionTypeCall, callStrike, quantity)
+}
+
+// IronCondor adds a short iron condor: a put credit spread (longPut/shortPut) and a call credit spread (shortCall/longCall).
+func (b *OrderBuilder) IronCondor(longPut, shortPut, shortCall, longCall float64, quantity int) *OrderBuilder {
+	return b.BuyToOpen(OptionTypePut, longPut, quantity).
+		SellToOpen(OptionTypePut, shortPut, quantity).
+		SellToOpen(OptionTypeCall, shortCall, quantity).
+		BuyToOpen(OptionTypeCall, longCall, quantity)
+}
+
+// TimeInForce sets the time in force of the order (default: "Day").
+func (b *OrderBuilder) TimeInForce(timeInForce string) *OrderBuilder {
+	b.timeInForce = timeInForce
+	return b
+}
+
+// GoodTilDate makes the order a GTD order expiring on date (YYYY-MM-DD format).
+func (b *OrderBuilder) GoodTilDate(date string) *OrderBuilder {
+	b.timeInForce = TimeInForceGTD
+	b.gtcDate = date
+	return b
+}
+
+// Limit sets the net limit price per spread, overriding any price derived from At.
+// A positive price is paid (Debit) and a negative price is received (Credit).
+func (b *OrderBuilder) Limit(netPrice float64) *OrderBuilder {
+	b.netPrice = NewDecimalFromFloat(netPrice)
+	b.netPriceSet = true
+	return b
+}
+
+// Build returns the assembled order, or the first error encountered while adding legs.
+// The order is a limit order when a net price is known (from Limit or from prices on every leg)
+// and a market order otherwise. Build fails when the net price rounds to zero, since the API has no even-money
+// limit orders. The net price is per spread, i.e. per unit of the leg ratio:
+// a 2x1 ratio spread with prices 1.00 and 1.50 has a net price of 2*1.00 - 1.50.
+func (b *OrderBuilder) Build() (Order, error) {
+	if b.err != nil {
+		return Order{}, b.err
+	}
+	if len(b.legs) == 0 {
+		return Order{}, errors.New("order has no legs")
+	}
+	if b.timeInForce == TimeInForceGTD && b.gtcDate == "" {
+		return Order{}, errors.New("GTD order requires a date")
+	}
+
+	order := Order{
+		TimeInForce: b.timeInForce,
+		GTCDate:     b.gtcDate,
+		OrderType:   OrderTypeMarket,
+		Legs:        make([]OrderLeg, 0, len(b.legs)),
+	}
+	for _, leg := range b.legs {
+		order.Legs = append(order.Legs, leg.OrderLeg)
+	}
+
+	netPrice, ok := b.netPrice, b.netPriceSet
+	if !ok {
+		netPrice, ok = b.legNetPrice()
+	}
+	if ok {
+		order.OrderType = OrderTypeLimit
+		order.Price = netPrice.Abs().Round(2)
+		if order.Price.IsZero() {
+			return Order{}, fmt.Errorf("net price %s rounds to 0.00; set a non-zero Limit or leave the legs unpriced for a market order", netPrice)
+		}
+		order.PriceEffect = EffectDebit
+		if netPrice.Sign() < 0 {
+			order.PriceEffect = EffectCredit
+		}
+	}
+	return order, nil
+}
+
+// legNetPrice derives the net price per spread from the leg prices. It reports false
+// when any leg has no price.
+func (b *OrderBuilder) legNetPrice() (Decimal, bool) {
+	unit := 0
+	for _, leg := range b.legs {
+		if !leg.priceSet {
+			return Decimal{}, false
+		}
+		unit = gcd(unit, leg.contracts)
+	}
+
+	var net Decimal
+	for _, leg := range b.legs {
+		cost := leg.price.Mul(NewDecimal(int64(leg.contracts/unit), 0))
+		switch leg.Action {
+		case OrderActionBuyToOpen, OrderActionBuyToClose, OrderActionBuy:
+			net = net.Add(cost)
+		default:
+			net = net.Sub(cost)
+		}
+	}
+	return net, true
+}
+
+// lookup returns the OCC symbol of the option with the given type and strike in the builder's expiration.
+func (b *OrderBuilder) lookup(optionType string, strike float64) (string, error) {
+	if optionType != OptionTypeCall && optionType != OptionTypePut {
+		return "", fmt.Errorf("invalid option type %q, expected %q or %q", optionType, OptionTypeCall, OptionTypePut)
+	}
+
+	foundExpiration := false
+	for _, item := range b.chain.Data.Items {
+		if b.rootSymbol != "" && item.RootSymbol != b.rootSymbol {
+			continue
+		}
+		for _, expiration := range item.Expirations {
+			if expiration.ExpirationDate != b.expirationDate {
+				continue
+			}
+			foundExpiration = true
+			for _, s := range expiration.Strikes {
+				strikePrice, err := strconv.ParseFloat(s.StrikePrice, 64)
+				if err != nil || math.Abs(strikePrice-strike) > 1e-9 {
+					continue
+				}
+				if optionType == OptionTypeCall {
+					return s.Call, nil
+				}
+				return s.Put, nil
+			}
+		}
+	}
+
+	if !foundExpiration {
+		return "", fmt.Errorf("expiration %s not found in option chain", b.expirationDate)
+	}
+	return "", fmt.Errorf("strike %g not found for expiration %s", strike, b.expirationDate)
+}
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,149 @@
+package tastytrade
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testOptionChain(t *testing.T) OptionChainsNestedResponse {
+	var chain OptionChainsNestedResponse
+	err := json.Unmarshal([]byte(`{"context": "test", "data": {"items": [{"underlying-symbol": "AAPL", "root-symbol": "AAPL", "shares-per-contract": 100, "expirations": [
+		{"expiration-date": "2024-09-20", "strikes": [
+			{"strike-price": "90.0", "call": "AAPL  240920C00090000", "put": "AAPL  240920P00090000"},
+			{"strike-price": "95.0", "call": "AAPL  240920C00095000", "put": "AAPL  240920P00095000"},
+			{"strike-price": "105.0", "call": "AAPL  240920C00105000", "put": "AAPL  240920P00105000"},
+			{"strike-price": "110.0", "call": "AAPL  240920C00110000", "put": "AAPL  240920P00110000"}]}]}]}}`), &chain)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	return chain
+}
+
+func TestOrderBuilderVertical(t *testing.T) {
+	order, err := NewOrderBuilder(testOptionChain(t), "2024-09-20").
+		SellToOpen(OptionTypePut, 95, 2).At(1.20).
+		BuyToOpen(OptionTypePut, 90, 2).At(0.45).
+		TimeInForce(TimeInForceGTC).
+		Build()
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if order.Legs[0].Symbol != "AAPL  240920P00095000" || order.Legs[0].Action != OrderActionSellToOpen {
+		t.Errorf("unexpected first leg %+v", order.Legs[0])
+	}
+
+	if order.Legs[1].Symbol != "AAPL  240920P00090000" || !order.Legs[1].Quantity.Equal(NewDecimal(2, 0)) {
+		t.Errorf("unexpected second leg %+v", order.Legs[1])
+	}
+
+	if order.OrderType != OrderTypeLimit || order.Price.String() != "0.75" || order.PriceEffect != EffectCredit {
+		t.Errorf("expected 0.75 credit limit, got %s %s %s", order.OrderType, order.Price, order.PriceEffect)
+	}
+
+	if order.TimeInForce != TimeInForceGTC {
+		t.Errorf("expected %s, got %s", TimeInForceGTC, order.TimeInForce)
+	}
+}
+
+func TestOrderBuilderIronCondor(t *testing.T) {
+	order, err := NewOrderBuilder(testOptionChain(t), "2024-09-20").
+		IronCondor(90, 95, 105, 110, 1).
+		Limit(-1.5).
+		Build()
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if len(order.Legs) != 4 {
+		t.Fatalf("expected %d, got %d", 4, len(order.Legs))
+	}
+
+	if order.Legs[3].Symbol != "AAPL  240920C00110000" || order.Legs[3].Action != OrderActionBuyToOpen {
+		t.Errorf("unexpected last leg %+v", order.Legs[3])
+	}
+
+	if !order.Price.Equal(MustParseDecimal("1.5")) || order.PriceEffect != EffectCredit {
+		t.Errorf("expected 1.5 credit, got %s %s", order.Price, order.PriceEffect)
+	}
+
+	body, _ := json.Marshal(order)
+	var payload map[string]interface{}
+	json.Unmarshal(body, &payload)
+	if payload["price"] != "1.5" || payload["price-effect"] != "Credit" || payload["order-type"] != "Limit" {
+		t.Errorf("unexpected order payload %s", body)
+	}
+}
+
+func TestOrderBuilderRatioDebit(t *testing.T) {
+	order, err := NewOrderBuilder(testOptionChain(t), "2024-09-20").
+		BuyToOpen(OptionTypeCall, 105, 2).At(1.00).
+		SellToOpen(OptionTypeCall, 110, 1).At(1.50).
+		Build()
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if !order.Price.Equal(MustParseDecimal("0.5")) || order.PriceEffect != EffectDebit {
+		t.Errorf("expected 0.5 debit, got %s %s", order.Price, order.PriceEffect)
+	}
+}
+
+func TestOrderBuilderMarketWithoutPrices(t *testing.T) {
+	order, err := NewOrderBuilder(testOptionChain(t), "2024-09-20").
+		ShortStrangle(95, 105, 1).
+		Build()
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if order.OrderType != OrderTypeMarket || order.PriceEffect != "" {
+		t.Errorf("expected market order without price effect, got %s %s", order.OrderType, order.PriceEffect)
+	}
+}
+
+func TestOrderBuilderZeroNetPrice(t *testing.T) {
+	// The leg prices cancel out to 0.002, which rounds to 0.00.
+	_, err := NewOrderBuilder(testOptionChain(t), "2024-09-20").
+		SellToOpen(OptionTypePut, 95, 1).At(1.202).
+		BuyToOpen(OptionTypePut, 90, 1).At(1.20).
+		Build()
+
+	if err == nil {
+		t.Errorf("expected error for zero net price, got nil")
+	}
+
+	_, err = NewOrderBuilder(testOptionChain(t), "2024-09-20").
+		SellToOpen(OptionTypePut, 95, 1).
+		BuyToOpen(OptionTypePut, 90, 1).
+		Limit(0).
+		Build()
+
+	if err == nil {
+		t.Errorf("expected error for zero limit, got nil")
+	}
+}
+
+func TestOrderBuilderErrors(t *testing.T) {
+	chain := testOptionChain(t)
+
+	if _, err := NewOrderBuilder(chain, "2024-09-27").BuyToOpen(OptionTypeCall, 105, 1).Build(); err == nil {
+		t.Errorf("expected missing expiration error, got nil")
+	}
+
+	if _, err := NewOrderBuilder(chain, "2024-09-20").BuyToOpen(OptionTypeCall, 100, 1).Build(); err == nil {
+		t.Errorf("expected missing strike error, got nil")
+	}
+
+	if _, err := NewOrderBuilder(chain, "2024-09-20").BuyToOpen("X", 105, 1).Build(); err == nil {
+		t.Errorf("expected invalid option type error, got nil")
+	}
+
+	if _, err := NewOrderBuilder(chain, "2024-09-20").Build(); err == nil {
+		t.Errorf("expected no legs error, got nil")
+	}
+}
@@ -0,0 +1,589 @@
+package tastytrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Order types accepted in Order.OrderType.
+const (
+	OrderTypeLimit          = "Limit"           // Fill at the given price or better
+	OrderTypeMarket         = "Market"          // Fill at the current market price
+	OrderTypeStop           = "Stop"            // Market order triggered at the stop price
+	OrderTypeStopLimit      = "Stop Limit"      // Limit order triggered at the stop price
+	OrderTypeNotionalMarket = "Notional Market" // Market order for a dollar amount (fractional equities and crypto)
+)
+
+// Time-in-force values accepted in Order.TimeInForce.
+const (
+	TimeInForceDay    = "Day"     // Expires at the end of the regular session
+	TimeInForceGTC    = "GTC"     // Good 'til canceled
+	TimeInForceGTD    = "GTD"     // Good 'til the date in Order.GTCDate
+	TimeInForceExt    = "Ext"     // Day order that also works in extended hours
+	TimeInForceGTCExt = "GTC Ext" // Good 'til canceled, including extended hours
+	TimeInForceIOC    = "IOC"     // Immediate or cancel
+)
+
+// Leg actions accepted in OrderLeg.Action.
+const (
+	OrderActionBuyToOpen   = "Buy to Open"
+	OrderActionBuyToClose  = "Buy to Close"
+	OrderActionSellToOpen  = "Sell to Open"
+	OrderActionSellToClose = "Sell to Close"
+	OrderActionBuy         = "Buy"  // Futures only
+	OrderActionSell        = "Sell" // Futures only
+)
+
+// Price effects, kept as aliases of the Effect constants.
+//
+// Deprecated: use EffectDebit and EffectCredit.
+const (
+	PriceEffectDebit  = EffectDebit  // The order costs money
+	PriceEffectCredit = EffectCredit // The order pays money
+)
+
+// OrderFill represents a single execution of an order leg.
+type OrderFill struct {
+	FillID           string  `json:"fill-id"`           // Fill identifier
+	ExtGroupFillID   string  `json:"ext-group-fill-id"` // External group fill identifier
+	ExtExecID        string  `json:"ext-exec-id"`       // External execution identifier
+	Quantity         Decimal `json:"quantity"`          // Filled quantity
+	FillPrice        Decimal `json:"fill-price"`        // Price of the fill
+	FilledAt         string  `json:"filled-at"`         // Fill timestamp
+	DestinationVenue string  `json:"destination-venue"` // Venue the fill came from
+}
+
+// OrderLeg represents a single instrument within an order.
+// Only InstrumentType, Symbol, Quantity and Action are sent when placing an order;
+// the remaining fields are filled in by the API.
+type OrderLeg struct {
+	InstrumentType    string      `json:"instrument-type"`    // Type: "Equity", "Equity Option", "Future", "Future Option", "Cryptocurrency"
+	Symbol            string      `json:"symbol"`             // Symbol of the instrument (e.g., "AAPL  240920C00220000")
+	Quantity          Decimal     `json:"quantity"`           // Quantity (omitted when zero, as for notional market orders)
+	Action            string      `json:"action"`             // Action: "Buy to Open", "Sell to Open", "Buy to Close", "Sell to Close", "Buy", "Sell"
+	RemainingQuantity Decimal     `json:"remaining-quantity"` // Quantity not yet filled (omitted when zero)
+	Fills             []OrderFill `json:"fills,omitempty"`    // Executions of this leg
+}
+
+// MarshalJSON encodes l, leaving out Quantity and RemainingQuantity when they are zero.
+func (l OrderLeg) MarshalJSON() ([]byte, error) {
+	type orderLeg OrderLeg // Drops the MarshalJSON method to avoid recursion
+	return json.Marshal(struct {
+		orderLeg
+		Quantity          *Decimal `json:"quantity,omitempty"`
+		RemainingQuantity *Decimal `json:"remaining-quantity,omitempty"`
+	}{
+		orderLeg:          orderLeg(l),
+		Quantity:          nonZeroDecimal(l.Quantity),
+		RemainingQuantity: nonZeroDecimal(l.RemainingQuantity),
+	})
+}
+
+// Order represents an order, both as submitted to PlaceOrder/DryRunOrder and as returned by the API.
+// Fields from ID onwards are read-only and ignored by the API when submitting.
+type Order struct {
+	TimeInForce  string     `json:"time-in-force"`           // Time in force: "Day", "GTC", "GTD", "Ext", "GTC Ext", "IOC"
+	GTCDate      string     `json:"gtc-date,omitempty"`      // Expiration date for GTD orders (YYYY-MM-DD format)
+	OrderType    string     `json:"order-type"`              // Order type: "Limit", "Market", "Stop", "Stop Limit", "Notional Market"
+	Price        Decimal    `json:"price"`                   // Limit price (required for limit orders; omitted when zero)
+	PriceEffect  Effect     `json:"price-effect,omitempty"`  // Price effect: "Debit" or "Credit"
+	StopTrigger  Decimal    `json:"stop-trigger"`            // Stop price (required for stop orders; omitted when zero)
+	Value        Decimal    `json:"value"`                   // Dollar amount for notional market orders (omitted when zero)
+	ValueEffect  Effect     `json:"value-effect,omitempty"`  // Value effect for notional market orders: "Debit" or "Credit"
+	Source       string     `json:"source,omitempty"`        // Free-form source of the order
+	PartitionKey string     `json:"partition-key,omitempty"` // Partition key for advisor accounts
+	PreflightID  string     `json:"preflight-id,omitempty"`  // Preflight identifier from a previous dry run
+	Legs         []OrderLeg `json:"legs"`                    // Order legs (at least one)
+
+	ID                       int64  `json:"id,omitempty"`                         // Order identifier
+	AccountNumber            string `json:"account-number,omitempty"`             // Account the order belongs to
+	UnderlyingSymbol         string `json:"underlying-symbol,omitempty"`          // Underlying symbol of the legs
+	UnderlyingInstrumentType string `json:"underlying-instrument-type,omitempty"` // Instrument type of the underlying
+	Status                   string `json:"status,omitempty"`                     // Status: "Received", "Routed", "Live", "Filled", "Cancelled", "Rejected", ...
+	Cancellable              bool   `json:"cancellable,omitempty"`                // Whether the order can still be canceled
+	Editable                 bool   `json:"editable,omitempty"`                   // Whether the order can still be replaced
+	Edited                   bool   `json:"edited,omitempty"`                     // Whether the order replaced an earlier order
+	ComplexOrderID           int64  `json:"complex-order-id,omitempty"`           // Complex order this order belongs to, if any
+	ComplexOrderTag          string `json:"complex-order-tag,omitempty"`          // Role within the complex order (e.g., "OTO::triggered-order")
+	RejectReason             string `json:"reject-reason,omitempty"`              // Reason the order was rejected, if any
+	ReceivedAt               string `json:"received-at,omitempty"`                // Timestamp the order was received
+	UpdatedAt                int64  `json:"updated-at,omitempty"`                 // Last update time (Unix milliseconds)
+	TerminalAt               string `json:"terminal-at,omitempty"`                // Timestamp the order reached a terminal status
+	CancelledAt              string `json:"cancelled-at,omitempty"`               // Timestamp the order was canceled
+}
+
+// MarshalJSON encodes o, leaving out Price, StopTrigger and Value when they are zero, since the API rejects
+// them on order types that do not take them.
+func (o Order) MarshalJSON() ([]byte, error) {
+	type order Order // Drops the MarshalJSON method to avoid recursion
+	return json.Marshal(struct {
+		order
+		Price       *Decimal `json:"price,omitempty"`
+		StopTrigger *Decimal `json:"stop-trigger,omitempty"`
+		Value       *Decimal `json:"value,omitempty"`
+	}{
+		order:       order(o),
+		Price:       nonZeroDecimal(o.Price),
+		StopTrigger: nonZeroDecimal(o.StopTrigger),
+		Value:       nonZeroDecimal(o.Value),
+	})
+}
+
+// nonZeroDecimal returns a pointer to d, or nil when d is zero.
+func nonZeroDecimal(d Decimal) *Decimal {
+	if d.IsZero() {
+		return nil
+	}
+	return &d
+}
+
+// BuyingPowerEffect describes how an order changes the account's buying power and margin requirement.
+type BuyingPowerEffect struct {
+	ChangeInMarginRequirement            Decimal `json:"change-in-margin-requirement"`             // Change in margin requirement
+	ChangeInMarginRequirementEffect      Effect  `json:"change-in-margin-requirement-effect"`      // Effect: "Credit", "Debit", or "None"
+	ChangeInBuyingPower                  Decimal `json:"change-in-buying-power"`                   // Change in buying power
+	ChangeInBuyingPowerEffect            Effect  `json:"change-in-buying-power-effect"`            // Effect: "Credit", "Debit", or "None"
+	CurrentBuyingPower                   Decimal `json:"current-buying-power"`                     // Buying power before the order
+	CurrentBuyingPowerEffect             Effect  `json:"current-buying-power-effect"`              // Effect: "Credit", "Debit", or "None"
+	NewBuyingPower                       Decimal `json:"new-buying-power"`                         // Buying power after the order
+	NewBuyingPowerEffect                 Effect  `json:"new-buying-power-effect"`                  // Effect: "Credit", "Debit", or "None"
+	IsolatedOrderMarginRequirement       Decimal `json:"isolated-order-margin-requirement"`        // Margin requirement of the order on its own
+	IsolatedOrderMarginRequirementEffect Effect  `json:"isolated-order-margin-requirement-effect"` // Effect: "Credit", "Debit", or "None"
+	IsSpread                             bool    `json:"is-spread"`                                // Whether the order is margined as a spread
+	Impact                               Decimal `json:"impact"`                                   // Overall buying power impact
+	Effect                               Effect  `json:"effect"`                                   // Effect of the impact: "Credit", "Debit", or "None"
+}
+
+// FeeCalculation describes the fees and commission an order is expected to incur.
+type FeeCalculation struct {
+	RegulatoryFees                   Decimal `json:"regulatory-fees"`                      // Regulatory fees
+	RegulatoryFeesEffect             Effect  `json:"regulatory-fees-effect"`               // Effect: "Credit", "Debit", or "None"
+	ClearingFees                     Decimal `json:"clearing-fees"`                        // Clearing fees
+	ClearingFeesEffect               Effect  `json:"clearing-fees-effect"`                 // Effect: "Credit", "Debit", or "None"
+	Commission                       Decimal `json:"commission"`                           // Commission
+	CommissionEffect                 Effect  `json:"commission-effect"`                    // Effect: "Credit", "Debit", or "None"
+	ProprietaryIndexOptionFees       Decimal `json:"proprietary-index-option-fees"`        // Proprietary index option fees
+	ProprietaryIndexOptionFeesEffect Effect  `json:"proprietary-index-option-fees-effect"` // Effect: "Credit", "Debit", or "None"
+	TotalFees                        Decimal `json:"total-fees"`                           // Total of all fees
+	TotalFeesEffect                  Effect  `json:"total-fees-effect"`                    // Effect: "Credit", "Debit", or "None"
+}
+
+// OrderMessage represents a warning or error attached to an order response.
+type OrderMessage struct {
+	Code        string `json:"code"`                   // Machine readable code (e.g., "tif_next_valid_sesssion")
+	Message     string `json:"message"`                // Human readable description
+	PreflightID string `json:"preflight-id,omitempty"` // Preflight check that produced the message
+}
+
+// OrderResult contains the order together with its expected buying power effect, fees, warnings and errors.
+type OrderResult struct {
+	Order             Order             `json:"order"`               // The order as accepted (or simulated) by the API
+	BuyingPowerEffect BuyingPowerEffect `json:"buying-power-effect"` // Buying power effect of the order
+	FeeCalculation    FeeCalculation    `json:"fee-calculation"`     // Fees of the order
+	Warnings          []OrderMessage    `json:"warnings"`            // Warnings that do not prevent the order
+	Errors            []OrderMessage    `json:"errors"`              // Errors that would prevent the order (dry run only)
+}
+
+// OrderResponse represents the response structure returned by PlaceOrder and DryRunOrder.
+type OrderResponse struct {
+	Data    OrderResult `json:"data"`    // Order result data
+	Context string      `json:"context"` // API context identifier
+}
+
+// OrdersResponse represents the response structure returned by ListLiveOrders.
+type OrdersResponse struct {
+	Data struct {
+		Items []Order `json:"items"` // Array of orders
+	} `json:"data"`
+	Context string `json:"context"` // API context identifier
+}
+
+// OrdersSearchResponse represents the response structure returned by SearchOrders.
+// It contains a page of orders together with pagination information.
+type OrdersSearchResponse struct {
+	Data struct {
+		Items []Order `json:"items"` // Array of orders
+	} `json:"data"`
+	APIVersion string     `json:"api-version"` // API version
+	Context    string     `json:"context"`     // API context identifier
+	Pagination Pagination `json:"pagination"`  // Pagination information
+}
+
+// OrderQueryParams represents query parameters for SearchOrders.
+type OrderQueryParams struct {
+	Status                   []string `json:"status"`                     // Only orders with one of these statuses (e.g., "Filled", "Cancelled")
+	UnderlyingSymbol         string   `json:"underlying-symbol"`          // Only orders for this underlying
+	UnderlyingInstrumentType string   `json:"underlying-instrument-type"` // Only orders whose underlying is of this type
+	FuturesSymbol            string   `json:"futures-symbol"`             // Only orders for this futures symbol
+	StartDate                string   `json:"start-date"`                 // Orders updated on or after this date (YYYY-MM-DD format)
+	EndDate                  string   `json:"end-date"`                   // Orders updated on or before this date (YYYY-MM-DD format)
+	StartAt                  string   `json:"start-at"`                   // Orders updated at or after this timestamp
+	EndAt                    string   `json:"end-at"`                     // Orders updated at or before this timestamp
+	Sort                     string   `json:"sort"`                       // Sort direction: "Asc" or "Desc" (default: "Desc")
+	PerPage                  int      `json:"per-page"`                   // Number of items per page (default: 10, max: 250)
+	PageOffset               int      `json:"page-offset"`                // Page offset for pagination (default: 0)
+}
+
+// OrderDetailResponse represents the response structure returned by GetOrder, CancelOrder, ReplaceOrder and EditOrderPrice.
+type OrderDetailResponse struct {
+	Data    Order  `json:"data"`    // Order data
+	Context string `json:"context"` // API context identifier
+}
+
+// orderEdit is the request body sent by EditOrderPrice. The edit endpoint expects every replaceable field
+// of the order, not only the ones that change.
+type orderEdit struct {
+	TimeInForce string   `json:"time-in-force"`          // Time in force of the order
+	GTCDate     string   `json:"gtc-date,omitempty"`     // Expiration date for GTD orders
+	OrderType   string   `json:"order-type"`             // Order type of the order
+	Price       Decimal  `json:"price"`                  // New limit price
+	PriceEffect Effect   `json:"price-effect"`           // Price effect: "Debit" or "Credit"
+	StopTrigger *Decimal `json:"stop-trigger,omitempty"` // Stop price of stop limit orders
+	Value       *Decimal `json:"value,omitempty"`        // Dollar amount of notional market orders
+	ValueEffect Effect   `json:"value-effect,omitempty"` // Value effect of notional market orders
+}
+
+// PlaceOrder submits an order for the specified account.
+// Returns an OrderResponse containing the accepted order, its buying power effect, fees and warnings.
+// In production this requires a client created with WithProductionWrites(true).
+func (api *TastytradeAPI) PlaceOrder(accountNumber string, order Order) (OrderResponse, error) {
+	return api.PlaceOrderCtx(context.Background(), accountNumber, order)
+}
+
+// PlaceOrderCtx is like PlaceOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) PlaceOrderCtx(ctx context.Context, accountNumber string, order Order) (OrderResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/orders", api.host, url.PathEscape(accountNumber))
+
+	data, err := api.postData(ctx, urlVal, order)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	var response OrderResponse
+	if err := decodeResponse(data, &response); err != nil {
+		return OrderResponse{}, err
+	}
+
+	return response, nil
+}
+
+// DryRunOrder validates an order for the specified account without submitting it.
+// Returns an OrderResponse containing the simulated order, its buying power effect, fees, warnings and errors.
+// Dry runs do not change any state and are therefore allowed in production without WithProductionWrites.
+func (api *TastytradeAPI) DryRunOrder(accountNumber string, order Order) (OrderResponse, error) {
+	return api.DryRunOrderCtx(context.Background(), accountNumber, order)
+}
+
+// DryRunOrderCtx is like DryRunOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) DryRunOrderCtx(ctx context.Context, accountNumber string, order Order) (OrderResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/orders/dry-run", api.host, url.PathEscape(accountNumber))
+
+	data, err := api.sendJSON(ctx, "POST", urlVal, order)
+	if err != nil {
+		return OrderResponse{}, err
+	}
+
+	var response OrderResponse
+	if err := decodeResponse(data, &response); err != nil {
+		return OrderResponse{}, err
+	}
+
+	return response, nil
+}
+
+// ListLiveOrders retrieves the orders of the specified account that are working or were updated today.
+// Returns an OrdersResponse containing an array of orders.
+func (api *TastytradeAPI) ListLiveOrders(accountNumber string) (OrdersResponse, error) {
+	return api.ListLiveOrdersCtx(context.Background(), accountNumber)
+}
+
+// ListLiveOrdersCtx is like ListLiveOrders but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListLiveOrdersCtx(ctx context.Context, accountNumber string) (OrdersResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/orders/live", api.host, url.PathEscape(accountNumber))
+
+	var response OrdersResponse
+	if err := api.fetchDataAndUnmarshal(ctx, urlVal, &response); err != nil {
+		return OrdersResponse{}, err
+	}
+
+	return response, nil
+}
+
+// GetOrder retrieves a single order of the specified account by ID.
+// Returns an OrderDetailResponse containing the order.
+func (api *TastytradeAPI) GetOrder(accountNumber string, orderID int64) (OrderDetailResponse, error) {
+	return api.GetOrderCtx(context.Background(), accountNumber, orderID)
+}
+
+// GetOrderCtx is like GetOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetOrderCtx(ctx context.Context, accountNumber string, orderID int64) (OrderDetailResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/orders/%d", api.host, url.PathEscape(accountNumber), orderID)
+
+	var response OrderDetailResponse
+	if err := api.fetchDataAndUnmarshal(ctx, urlVal, &response); err != nil {
+		return OrderDetailResponse{}, err
+	}
+
+	return response, nil
+}
+
+// CancelOrder requests cancellation of a working order.
+// Returns an OrderDetailResponse containing the order, whose status becomes "Cancel Requested" or "Cancelled".
+// In production this requires a client created with WithProductionWrites(true).
+func (api *TastytradeAPI) CancelOrder(accountNumber string, orderID int64) (OrderDetailResponse, error) {
+	return api.CancelOrderCtx(context.Background(), accountNumber, orderID)
+}
+
+// CancelOrderCtx is like CancelOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) CancelOrderCtx(ctx context.Context, accountNumber string, orderID int64) (OrderDetailResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/orders/%d", api.host, url.PathEscape(accountNumber), orderID)
+
+	data, err := api.deleteData(ctx, urlVal)
+	if err != nil {
+		return OrderDetailResponse{}, err
+	}
+
+	var response OrderDetailResponse
+	if err := decodeResponse(data, &response); err != nil {
+		return OrderDetailResponse{}, err
+	}
+
+	return response, nil
+}
+
+// ReplaceOrder replaces a working order with a new order (cancel/replace).
+// Returns an OrderDetailResponse containing the new order; the original order is canceled by the API.
+// In production this requires a client created with WithProductionWrites(true).
+func (api *TastytradeAPI) ReplaceOrder(accountNumber string, orderID int64, order Order) (OrderDetailResponse, error) {
+	return api.ReplaceOrderCtx(context.Background(), accountNumber, orderID, order)
+}
+
+// ReplaceOrderCtx is like ReplaceOrder but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ReplaceOrderCtx(ctx context.Context, accountNumber string, orderID int64, order Order) (OrderDetailResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/orders/%d", api.host, url.PathEscape(accountNumber), orderID)
+
+	data, err := api.putData(ctx, urlVal, order)
+	if err != nil {
+		return OrderDetailResponse{}, err
+	}
+
+	var response OrderDetailResponse
+	if err := decodeResponse(data, &response); err != nil {
+		return OrderDetailResponse{}, err
+	}
+
+	return response, nil
+}
+
+// EditOrderPrice changes the limit price of a working order without resubmitting its legs.
+// The edit endpoint expects all replaceable fields, so the order is fetched first and its order type,
+// time in force, GTC date, stop trigger and value are sent along with the new price.
+// Returns an OrderDetailResponse containing the edited order.
+// In production this requires a client created with WithProductionWrites(true).
+func (api *TastytradeAPI) EditOrderPrice(accountNumber string, orderID int64, price Decimal, priceEffect Effect) (OrderDetailResponse, error) {
+	return api.EditOrderPriceCtx(context.Background(), accountNumber, orderID, price, priceEffect)
+}
+
+// EditOrderPriceCtx is like EditOrderPrice but carries ctx on the outgoing request.
+func (api *TastytradeAPI) EditOrderPriceCtx(ctx context.Context, accountNumber string, orderID int64, price Decimal, priceEffect Effect) (OrderDetailResponse, error) {
+	if err := api.checkWriteAllowed(); err != nil {
+		return OrderDetailResponse{}, err
+	}
+	current, err := api.GetOrderCtx(ctx, accountNumber, orderID)
+	if err != nil {
+		return OrderDetailResponse{}, err
+	}
+	order := current.Data
+
+	urlVal := fmt.Sprintf("%s/accounts/%s/orders/%d", api.host, url.PathEscape(accountNumber), orderID)
+
+	data, err := api.patchData(ctx, urlVal, orderEdit{
+		TimeInForce: order.TimeInForce,
+		GTCDate:     order.GTCDate,
+		OrderType:   order.OrderType,
+		Price:       price,
+		PriceEffect: priceEffect,
+		StopTrigger: nonZeroDecimal(order.StopTrigger),
+		Value:       nonZeroDecimal(order.Value),
+		ValueEffect: order.ValueEffect,
+	})
+	if err != nil {
+		return OrderDetailResponse{}, err
+	}
+
+	var response OrderDetailResponse
+	if err := decodeResponse(data, &response); err != nil {
+		return OrderDetailResponse{}, err
+	}
+
+	return response, nil
+}
+
+// SearchOrders retrieves a page of orders for a specific account with optional filtering.
+// params can be nil to retrieve the most recent orders, or can filter by status, underlying symbol,
+// date range and sort order and select the page to return.
+// Returns an OrdersSearchResponse containing a paginated list of matching orders.
+// Use IterateOrders to walk every page.
+func (api *TastytradeAPI) SearchOrders(accountNumber string, params *OrderQueryParams) (OrdersSearchResponse, error) {
+	return api.SearchOrdersCtx(context.Background(), accountNumber, params)
+}
+
+// SearchOrdersCtx is like SearchOrders but carries ctx on the outgoing request.
+func (api *TastytradeAPI) SearchOrdersCtx(ctx context.Context, accountNumber string, params *OrderQueryParams) (OrdersSearchResponse, error) {
+	urlVal := fmt.Sprintf("%s/accounts/%s/orders", api.host, url.PathEscape(accountNumber))
+
+	if params != nil {
+		queryParams := url.Values{}
+		for _, status := range params.Status {
+			queryParams.Add("status[]", status)
+		}
+		if params.UnderlyingSymbol != "" {
+			queryParams.Add("underlying-symbol", params.UnderlyingSymbol)
+		}
+		if params.UnderlyingInstrumentType != "" {
+			queryParams.Add("underlying-instrument-type", params.UnderlyingInstrumentType)
+		}
+		if params.FuturesSymbol != "" {
+			queryParams.Add("futures-symbol", params.FuturesSymbol)
+		}
+		if params.StartDate != "" {
+			queryParams.Add("start-date", params.StartDate)
+		}
+		if params.EndDate != "" {
+			queryParams.Add("end-date", params.EndDate)
+		}
+		if params.StartAt != "" {
+			queryParams.Add("start-at", params.StartAt)
+		}
+		if params.EndAt != "" {
+			queryParams.Add("end-at", params.EndAt)
+		}
+		if params.Sort != "" {
+			queryParams.Add("sort", params.Sort)
+		}
+		if params.PerPage > 0 {
+			queryParams.Add("per-page", strconv.Itoa(params.PerPage))
+		}
+		if params.PageOffset > 0 {
+			queryParams.Add("page-offset", strconv.Itoa(params.PageOffset))
+		}
+		if len(queryParams) > 0 {
+			urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
+		}
+	}
+
+	var response OrdersSearchResponse
+	if err := api.fetchDataAndUnmarshal(ctx, urlVal, &response); err != nil {
+		return OrdersSearchResponse{}, err
+	}
+
+	return response, nil
+}
+
+// OrderIterator walks every order matching a search, fetching pages on demand.
+// It is created by IterateOrders and used like bufio.Scanner:
+//
+//	it := api.IterateOrders(accountNumber, &tastytrade.OrderQueryParams{Status: []string{"Filled"}})
+//	for it.Next() {
+//		order := it.Order()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// An OrderIterator is not safe for concurrent use.
+type OrderIterator struct {
+	api           *TastytradeAPI
+	ctx           context.Context
+	accountNumber string
+	params        OrderQueryParams
+	page          []Order
+	index         int
+	pagination    Pagination
+	fetched       bool
+	done          bool
+	err           error
+}
+
+// IterateOrders returns an iterator over every order matching params, starting at params.PageOffset.
+// params can be nil to iterate over all orders.
+func (api *TastytradeAPI) IterateOrders(accountNumber string, params *OrderQueryParams) *OrderIterator {
+	return api.IterateOrdersCtx(context.Background(), accountNumber, params)
+}
+
+// IterateOrdersCtx is like IterateOrders but carries ctx on every page request.
+func (api *TastytradeAPI) IterateOrdersCtx(ctx context.Context, accountNumber string, params *OrderQueryParams) *OrderIterator {
+	it := &OrderIterator{api: api, ctx: ctx, accountNumber: accountNumber}
+	if params != nil {
+		it.params = *params
+		it.params.Status = append([]string(nil), params.Status...)
+	}
+	return it
+}
+
+// Next advances to the next order, fetching the next page when the current one is exhausted.
+// It returns false when there are no more orders or a request failed; check Err to tell them apart.
+func (it *OrderIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for it.index+1 >= len(it.page) {
+		if it.done {
+			return false
+		}
+		if !it.fetchPage() {
+			return false
+		}
+	}
+	it.index++
+	return true
+}
+
+// Order returns the current order. It must only be called after Next returned true.
+func (it *OrderIterator) Order() Order {
+	return it.page[it.index]
+}
+
+// Pagination returns the pagination information of the most recently fetched page.
+func (it *OrderIterator) Pagination() Pagination {
+	return it.pagination
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *OrderIterator) Err() error {
+	return it.err
+}
+
+// fetchPage loads the next page into the iterator. It returns false when iteration must stop.
+func (it *OrderIterator) fetchPage() bool {
+	if it.fetched {
+		it.params.PageOffset++
+	}
+	resp, err := it.api.SearchOrdersCtx(it.ctx, it.accountNumber, &it.params)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.fetched = true
+	it.page = resp.Data.Items
+	it.index = -1
+	it.pagination = resp.Pagination
+
+	if len(it.page) == 0 || it.params.PageOffset+1 >= resp.Pagination.TotalPages {
+		it.done = true
+	}
+	return len(it.page) > 0
+}
+
+// decodeResponse converts a decoded JSON response into the response structure pointed to by v.
+func decodeResponse(data map[string]interface{}, v interface{}) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonData, v)
+}
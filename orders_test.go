@@ -0,0 +1,417 @@
+package tastytrade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const orderResponseJSON = `{"context": "/accounts/123456/orders", "data": {
+	"order": {"id": 1001, "account-number": "123456", "time-in-force": "Day", "order-type": "Limit", "price": "1.05", "price-effect": "Debit", "status": "Received", "cancellable": true, "editable": true,
+		"legs": [{"instrument-type": "Equity Option", "symbol": "AAPL  240920C00220000", "quantity": 1, "remaining-quantity": 1, "action": "Buy to Open", "fills": []}]},
+	"buying-power-effect": {"change-in-buying-power": "105.0", "change-in-buying-power-effect": "Debit", "is-spread": false, "impact": "105.0", "effect": "Debit"},
+	"fee-calculation": {"commission": "1.0", "commission-effect": "Debit", "total-fees": "1.14", "total-fees-effect": "Debit"},
+	"warnings": [{"code": "tif_next_valid_sesssion", "message": "Your order will begin working during next valid session."}],
+	"errors": []}}`
+
+func testOrder() Order {
+	return Order{
+		TimeInForce: TimeInForceDay,
+		OrderType:   OrderTypeLimit,
+		Price:       MustParseDecimal("1.05"),
+		PriceEffect: EffectDebit,
+		Legs: []OrderLeg{
+			{InstrumentType: "Equity Option", Symbol: "AAPL  240920C00220000", Quantity: NewDecimal(1, 0), Action: OrderActionBuyToOpen},
+		},
+	}
+}
+
+func TestPlaceOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "POST" || req.URL.Path != "/accounts/123456/orders" {
+			t.Errorf("got: %s %s, want: POST /accounts/123456/orders", req.Method, req.URL.Path)
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		if body["price"] != "1.05" || body["order-type"] != "Limit" {
+			t.Errorf("unexpected order body %v", body)
+		}
+		if _, ok := body["id"]; ok {
+			t.Errorf("expected read-only fields to be omitted, got %v", body)
+		}
+
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(orderResponseJSON))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	resp, err := api.PlaceOrder("123456", testOrder())
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Order.ID != 1001 {
+		t.Errorf("expected %d, got %d", 1001, resp.Data.Order.ID)
+	}
+
+	if resp.Data.Order.Price.String() != "1.05" {
+		t.Errorf("expected %s, got %s", "1.05", resp.Data.Order.Price)
+	}
+
+	if !resp.Data.BuyingPowerEffect.ChangeInBuyingPower.Equal(NewDecimal(105, 0)) {
+		t.Errorf("expected %s, got %s", "105", resp.Data.BuyingPowerEffect.ChangeInBuyingPower)
+	}
+
+	if resp.Data.FeeCalculation.TotalFees.String() != "1.14" {
+		t.Errorf("expected %s, got %s", "1.14", resp.Data.FeeCalculation.TotalFees)
+	}
+
+	if len(resp.Data.Warnings) != 1 {
+		t.Errorf("expected %d, got %d", 1, len(resp.Data.Warnings))
+	}
+}
+
+func TestPlaceOrderRequiresProductionWrites(t *testing.T) {
+	api := New()
+	_, err := api.PlaceOrder("123456", testOrder())
+
+	if !errors.Is(err, ErrProductionWritesDisabled) {
+		t.Errorf("expected %v, got %v", ErrProductionWritesDisabled, err)
+	}
+}
+
+func TestDryRunOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "POST" || req.URL.Path != "/accounts/123456/orders/dry-run" {
+			t.Errorf("got: %s %s, want: POST /accounts/123456/orders/dry-run", req.Method, req.URL.Path)
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"order": {"order-type": "Limit", "status": "Received", "legs": []},
+			"buying-power-effect": {"change-in-buying-power": "500.0", "change-in-buying-power-effect": "Debit"},
+			"fee-calculation": {"total-fees": "0.0"},
+			"warnings": [],
+			"errors": [{"code": "insufficient_buying_power", "message": "Insufficient buying power", "preflight-id": "7"}]}}`))
+	}))
+	defer server.Close()
+
+	// Dry runs go through without WithProductionWrites.
+	api := NewTastytradeAPI(server.URL)
+	resp, err := api.DryRunOrder("123456", testOrder())
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if len(resp.Data.Errors) != 1 {
+		t.Fatalf("expected %d, got %d", 1, len(resp.Data.Errors))
+	}
+
+	if resp.Data.Errors[0].Code != "insufficient_buying_power" {
+		t.Errorf("expected %s, got %s", "insufficient_buying_power", resp.Data.Errors[0].Code)
+	}
+}
+
+const orderDetailJSON = `{"context": "test", "data": {"id": 1001, "account-number": "123456", "order-type": "Limit", "price": "1.10", "price-effect": "Debit", "status": "%s", "legs": []}}`
+
+func TestListLiveOrders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "GET" || req.URL.Path != "/accounts/123456/orders/live" {
+			t.Errorf("got: %s %s, want: GET /accounts/123456/orders/live", req.Method, req.URL.Path)
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"items": [{"id": 1001, "status": "Live", "legs": []}, {"id": 1002, "status": "Filled", "legs": []}]}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	resp, err := api.ListLiveOrders("123456")
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if len(resp.Data.Items) != 2 {
+		t.Fatalf("expected %d, got %d", 2, len(resp.Data.Items))
+	}
+
+	if resp.Data.Items[1].Status != "Filled" {
+		t.Errorf("expected %s, got %s", "Filled", resp.Data.Items[1].Status)
+	}
+}
+
+func TestGetOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "GET" || req.URL.Path != "/accounts/123456/orders/1001" {
+			t.Errorf("got: %s %s, want: GET /accounts/123456/orders/1001", req.Method, req.URL.Path)
+		}
+		rw.Write([]byte(fmt.Sprintf(orderDetailJSON, "Live")))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	resp, err := api.GetOrder("123456", 1001)
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.ID != 1001 {
+		t.Errorf("expected %d, got %d", 1001, resp.Data.ID)
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "DELETE" || req.URL.Path != "/accounts/123456/orders/1001" {
+			t.Errorf("got: %s %s, want: DELETE /accounts/123456/orders/1001", req.Method, req.URL.Path)
+		}
+		if req.ContentLength > 0 {
+			t.Errorf("expected no body, got %d bytes", req.ContentLength)
+		}
+		rw.Write([]byte(fmt.Sprintf(orderDetailJSON, "Cancel Requested")))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	resp, err := api.CancelOrder("123456", 1001)
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Status != "Cancel Requested" {
+		t.Errorf("expected %s, got %s", "Cancel Requested", resp.Data.Status)
+	}
+}
+
+func TestReplaceOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "PUT" || req.URL.Path != "/accounts/123456/orders/1001" {
+			t.Errorf("got: %s %s, want: PUT /accounts/123456/orders/1001", req.Method, req.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		if body["price"] != "1.05" {
+			t.Errorf("expected %s, got %v", "1.05", body["price"])
+		}
+		rw.Write([]byte(fmt.Sprintf(orderDetailJSON, "Routed")))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	resp, err := api.ReplaceOrder("123456", 1001, testOrder())
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Status != "Routed" {
+		t.Errorf("expected %s, got %s", "Routed", resp.Data.Status)
+	}
+}
+
+func TestEditOrderPrice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/accounts/123456/orders/1001" {
+			t.Errorf("got: %s, want: /accounts/123456/orders/1001", req.URL.Path)
+		}
+		if req.Method == "GET" {
+			rw.Write([]byte(`{"context": "test", "data": {"id": 1001, "time-in-force": "GTD", "gtc-date": "2024-09-20", "order-type": "Stop Limit", "price": "1.05", "price-effect": "Debit", "stop-trigger": "1.00", "status": "Live", "legs": []}}`))
+			return
+		}
+		if req.Method != "PATCH" {
+			t.Errorf("got: %s, want: PATCH", req.Method)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		expected := map[string]interface{}{
+			"time-in-force": "GTD",
+			"gtc-date":      "2024-09-20",
+			"order-type":    "Stop Limit",
+			"price":         "1.10",
+			"price-effect":  "Debit",
+			"stop-trigger":  "1.00",
+		}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("expected %v, got %v", expected, body)
+		}
+		rw.Write([]byte(fmt.Sprintf(orderDetailJSON, "Live")))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	resp, err := api.EditOrderPrice("123456", 1001, MustParseDecimal("1.10"), EffectDebit)
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Price.String() != "1.10" {
+		t.Errorf("expected %s, got %s", "1.10", resp.Data.Price)
+	}
+}
+
+func TestOrderWritesRequireProductionWrites(t *testing.T) {
+	api := New()
+
+	if _, err := api.CancelOrder("123456", 1001); !errors.Is(err, ErrProductionWritesDisabled) {
+		t.Errorf("expected %v, got %v", ErrProductionWritesDisabled, err)
+	}
+
+	if _, err := api.ReplaceOrder("123456", 1001, testOrder()); !errors.Is(err, ErrProductionWritesDisabled) {
+		t.Errorf("expected %v, got %v", ErrProductionWritesDisabled, err)
+	}
+
+	if _, err := api.EditOrderPrice("123456", 1001, MustParseDecimal("1.10"), EffectDebit); !errors.Is(err, ErrProductionWritesDisabled) {
+		t.Errorf("expected %v, got %v", ErrProductionWritesDisabled, err)
+	}
+}
+
+func TestSearchOrders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/accounts/123456/orders" {
+			t.Errorf("got: %s, want: /accounts/123456/orders", req.URL.Path)
+		}
+		query := req.URL.Query()
+		if len(query["status[]"]) != 2 || query.Get("underlying-symbol") != "AAPL" || query.Get("start-date") != "2024-09-01" || query.Get("sort") != "Asc" || query.Get("per-page") != "50" {
+			t.Errorf("unexpected query %s", req.URL.RawQuery)
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"items": [{"id": 1001, "status": "Filled", "legs": []}]}, "pagination": {"per-page": 50, "page-offset": 0, "total-items": 1, "total-pages": 1}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	resp, err := api.SearchOrders("123456", &OrderQueryParams{
+		Status:           []string{"Filled", "Cancelled"},
+		UnderlyingSymbol: "AAPL",
+		StartDate:        "2024-09-01",
+		Sort:             "Asc",
+		PerPage:          50,
+	})
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if len(resp.Data.Items) != 1 {
+		t.Errorf("expected %d, got %d", 1, len(resp.Data.Items))
+	}
+
+	if resp.Pagination.TotalItems != 1 {
+		t.Errorf("expected %d, got %d", 1, resp.Pagination.TotalItems)
+	}
+}
+
+func TestIterateOrders(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		pages++
+		offset := req.URL.Query().Get("page-offset")
+		if req.URL.Query().Get("underlying-symbol") != "AAPL" {
+			t.Errorf("expected filters on every page, got %s", req.URL.RawQuery)
+		}
+		switch offset {
+		case "":
+			rw.Write([]byte(`{"context": "test", "data": {"items": [{"id": 1, "legs": []}, {"id": 2, "legs": []}]}, "pagination": {"per-page": 2, "page-offset": 0, "total-items": 5, "total-pages": 3}}`))
+		case "1":
+			rw.Write([]byte(`{"context": "test", "data": {"items": [{"id": 3, "legs": []}, {"id": 4, "legs": []}]}, "pagination": {"per-page": 2, "page-offset": 1, "total-items": 5, "total-pages": 3}}`))
+		case "2":
+			rw.Write([]byte(`{"context": "test", "data": {"items": [{"id": 5, "legs": []}]}, "pagination": {"per-page": 2, "page-offset": 2, "total-items": 5, "total-pages": 3}}`))
+		default:
+			t.Errorf("unexpected page-offset %s", offset)
+		}
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	it := api.IterateOrders("123456", &OrderQueryParams{UnderlyingSymbol: "AAPL", PerPage: 2})
+
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Order().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if len(ids) != 5 || ids[0] != 1 || ids[4] != 5 {
+		t.Errorf("expected orders 1 through 5, got %v", ids)
+	}
+
+	if pages != 3 {
+		t.Errorf("expected %d, got %d", 3, pages)
+	}
+}
+
+func TestIterateOrdersError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("page-offset") == "1" {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"items": [{"id": 1, "legs": []}]}, "pagination": {"per-page": 1, "page-offset": 0, "total-items": 2, "total-pages": 2}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	it := api.IterateOrders("123456", nil)
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+
+	if count != 1 {
+		t.Errorf("expected %d, got %d", 1, count)
+	}
+
+	if it.Err() == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestOrderMarshalOmitsZeroAmounts(t *testing.T) {
+	order := testOrder()
+	order.OrderType = OrderTypeMarket
+	order.Price = Decimal{}
+	order.PriceEffect = ""
+
+	data, err := json.Marshal(order)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	var body map[string]interface{}
+	json.Unmarshal(data, &body)
+	for _, field := range []string{"price", "stop-trigger", "value"} {
+		if _, ok := body[field]; ok {
+			t.Errorf("expected %s to be omitted, got %s", field, data)
+		}
+	}
+	if body["order-type"] != OrderTypeMarket {
+		t.Errorf("expected %s, got %v", OrderTypeMarket, body["order-type"])
+	}
+
+	leg := body["legs"].([]interface{})[0].(map[string]interface{})
+	if leg["quantity"] != "1" {
+		t.Errorf("expected %s, got %v", "1", leg["quantity"])
+	}
+	if _, ok := leg["remaining-quantity"]; ok {
+		t.Errorf("expected remaining-quantity to be omitted, got %s", data)
+	}
+
+	order.OrderType = OrderTypeNotionalMarket
+	order.Value = MustParseDecimal("100")
+	order.Legs[0].Quantity = Decimal{}
+	data, _ = json.Marshal(order)
+	if strings.Contains(string(data), `"quantity"`) {
+		t.Errorf("expected quantity to be omitted, got %s", data)
+	}
+}
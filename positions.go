@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -8,29 +9,41 @@ import (
 // Position represents a trading position in an account.
 // It contains information about the position including quantity, prices, P&L, and status.
 type Position struct {
-	AccountNumber                 string `json:"account-number"`                    // Account number holding the position
-	Symbol                        string `json:"symbol"`                            // Symbol of the position
-	InstrumentType                string `json:"instrument-type"`                   // Type of instrument (Equity, Option, Future, etc.)
-	UnderlyingSymbol              string `json:"underlying-symbol"`                 // Underlying symbol for derivatives
-	Quantity                      string `json:"quantity"`                          // Position quantity
-	QuantityDirection             string `json:"quantity-direction"`                // Direction: "Long" or "Short"
-	ClosePrice                    string `json:"close-price"`                       // Closing price
-	AverageOpenPrice              string `json:"average-open-price"`                // Average price at which position was opened
-	AverageYearlyMarketClosePrice string `json:"average-yearly-market-close-price"` // Average yearly market close price
-	AverageDailyMarketClosePrice  string `json:"average-daily-market-close-price"`  // Average daily market close price
-	Multiplier                    int    `json:"multiplier"`                        // Contract multiplier
-	CostEffect                    string `json:"cost-effect"`                       // Cost effect: "Debit" or "Credit"
-	IsSuppressed                  bool   `json:"is-suppressed"`                     // Whether position is suppressed
-	IsFrozen                      bool   `json:"is-frozen"`                         // Whether position is frozen
-	RestrictedQuantity            string `json:"restricted-quantity"`               // Quantity that is restricted
-	RealizedDayGain               string `json:"realized-day-gain"`                 // Realized gain for the day
-	RealizedDayGainEffect         string `json:"realized-day-gain-effect"`          // Effect of day gain: "Debit" or "Credit"
-	RealizedDayGainDate           string `json:"realized-day-gain-date"`            // Date of realized day gain
-	RealizedToday                 string `json:"realized-today"`                    // Realized P&L for today
-	RealizedTodayEffect           string `json:"realized-today-effect"`             // Effect of today's realized P&L: "Debit" or "Credit"
-	RealizedTodayDate             string `json:"realized-today-date"`               // Date of today's realized P&L
-	CreatedAt                     string `json:"created-at"`                        // Position creation timestamp
-	UpdatedAt                     string `json:"updated-at"`                        // Position last update timestamp
+	AccountNumber                 string  `json:"account-number"`                    // Account number holding the position
+	Symbol                        string  `json:"symbol"`                            // Symbol of the position
+	InstrumentType                string  `json:"instrument-type"`                   // Type of instrument (Equity, Option, Future, etc.)
+	UnderlyingSymbol              string  `json:"underlying-symbol"`                 // Underlying symbol for derivatives
+	Quantity                      Decimal `json:"quantity"`                          // Position quantity
+	QuantityDirection             string  `json:"quantity-direction"`                // Direction: "Long" or "Short"
+	ClosePrice                    Decimal `json:"close-price"`                       // Closing price
+	AverageOpenPrice              Decimal `json:"average-open-price"`                // Average price at which position was opened
+	AverageYearlyMarketClosePrice Decimal `json:"average-yearly-market-close-price"` // Average yearly market close price
+	AverageDailyMarketClosePrice  Decimal `json:"average-daily-market-close-price"`  // Average daily market close price
+	Multiplier                    int     `json:"multiplier"`                        // Contract multiplier
+	CostEffect                    Effect  `json:"cost-effect"`                       // Cost effect: "Debit" or "Credit"
+	IsSuppressed                  bool    `json:"is-suppressed"`                     // Whether position is suppressed
+	IsFrozen                      bool    `json:"is-frozen"`                         // Whether position is frozen
+	RestrictedQuantity            Decimal `json:"restricted-quantity"`               // Quantity that is restricted
+	RealizedDayGain               Decimal `json:"realized-day-gain"`                 // Realized gain for the day
+	RealizedDayGainEffect         Effect  `json:"realized-day-gain-effect"`          // Effect of day gain: "Debit", "Credit" or "None"
+	RealizedDayGainDate           string  `json:"realized-day-gain-date"`            // Date of realized day gain
+	RealizedToday                 Decimal `json:"realized-today"`                    // Realized P&L for today
+	RealizedTodayEffect           Effect  `json:"realized-today-effect"`             // Effect of today's realized P&L: "Debit", "Credit" or "None"
+	RealizedTodayDate             string  `json:"realized-today-date"`               // Date of today's realized P&L
+	CreatedAt                     string  `json:"created-at"`                        // Position creation timestamp
+	UpdatedAt                     string  `json:"updated-at"`                        // Position last update timestamp
+}
+
+// SignedRealizedDayGain returns RealizedDayGain signed by RealizedDayGainEffect: negative for a loss (Debit),
+// positive for a gain (Credit) and 0 for None.
+func (p Position) SignedRealizedDayGain() Decimal {
+	return p.RealizedDayGainEffect.Apply(p.RealizedDayGain)
+}
+
+// SignedRealizedToday returns RealizedToday signed by RealizedTodayEffect: negative for a loss (Debit),
+// positive for a gain (Credit) and 0 for None.
+func (p Position) SignedRealizedToday() Decimal {
+	return p.RealizedTodayEffect.Apply(p.RealizedToday)
 }
 
 // PositionsResponse represents the response structure returned by GetPositions.
@@ -42,27 +55,28 @@ type PositionsResponse struct {
 	} `json:"data"`
 }
 
-// positionRaw is used for flexible unmarshaling of Position fields that may be numbers or strings
+// positionRaw is used for flexible unmarshaling of Position fields that may be numbers or strings.
+// Decimal fields accept both encodings on their own.
 type positionRaw struct {
 	AccountNumber                 interface{} `json:"account-number"`
 	Symbol                        interface{} `json:"symbol"`
 	InstrumentType                interface{} `json:"instrument-type"`
 	UnderlyingSymbol              interface{} `json:"underlying-symbol"`
-	Quantity                      interface{} `json:"quantity"`
+	Quantity                      Decimal     `json:"quantity"`
 	QuantityDirection             interface{} `json:"quantity-direction"`
-	ClosePrice                    interface{} `json:"close-price"`
-	AverageOpenPrice              interface{} `json:"average-open-price"`
-	AverageYearlyMarketClosePrice interface{} `json:"average-yearly-market-close-price"`
-	AverageDailyMarketClosePrice  interface{} `json:"average-daily-market-close-price"`
+	ClosePrice                    Decimal     `json:"close-price"`
+	AverageOpenPrice              Decimal     `json:"average-open-price"`
+	AverageYearlyMarketClosePrice Decimal     `json:"average-yearly-market-close-price"`
+	AverageDailyMarketClosePrice  Decimal     `json:"average-daily-market-close-price"`
 	Multiplier                    interface{} `json:"multiplier"`
 	CostEffect                    interface{} `json:"cost-effect"`
 	IsSuppressed                  interface{} `json:"is-suppressed"`
 	IsFrozen                      interface{} `json:"is-frozen"`
-	RestrictedQuantity            interface{} `json:"restricted-quantity"`
-	RealizedDayGain               interface{} `json:"realized-day-gain"`
+	RestrictedQuantity            Decimal     `json:"restricted-quantity"`
+	RealizedDayGain               Decimal     `json:"realized-day-gain"`
 	RealizedDayGainEffect         interface{} `json:"realized-day-gain-effect"`
 	RealizedDayGainDate           interface{} `json:"realized-day-gain-date"`
-	RealizedToday                 interface{} `json:"realized-today"`
+	RealizedToday                 Decimal     `json:"realized-today"`
 	RealizedTodayEffect           interface{} `json:"realized-today-effect"`
 	RealizedTodayDate             interface{} `json:"realized-today-date"`
 	CreatedAt                     interface{} `json:"created-at"`
@@ -139,22 +153,22 @@ func convertPositionRaw(raw positionRaw) Position {
 		Symbol:                        convertToString(raw.Symbol),
 		InstrumentType:                convertToString(raw.InstrumentType),
 		UnderlyingSymbol:              convertToString(raw.UnderlyingSymbol),
-		Quantity:                      convertToString(raw.Quantity),
+		Quantity:                      raw.Quantity,
 		QuantityDirection:             convertToString(raw.QuantityDirection),
-		ClosePrice:                    convertToString(raw.ClosePrice),
-		AverageOpenPrice:              convertToString(raw.AverageOpenPrice),
-		AverageYearlyMarketClosePrice: convertToString(raw.AverageYearlyMarketClosePrice),
-		AverageDailyMarketClosePrice:  convertToString(raw.AverageDailyMarketClosePrice),
+		ClosePrice:                    raw.ClosePrice,
+		AverageOpenPrice:              raw.AverageOpenPrice,
+		AverageYearlyMarketClosePrice: raw.AverageYearlyMarketClosePrice,
+		AverageDailyMarketClosePrice:  raw.AverageDailyMarketClosePrice,
 		Multiplier:                    convertToInt(raw.Multiplier),
-		CostEffect:                    convertToString(raw.CostEffect),
+		CostEffect:                    Effect(convertToString(raw.CostEffect)),
 		IsSuppressed:                  convertToBool(raw.IsSuppressed),
 		IsFrozen:                      convertToBool(raw.IsFrozen),
-		RestrictedQuantity:            convertToString(raw.RestrictedQuantity),
-		RealizedDayGain:               convertToString(raw.RealizedDayGain),
-		RealizedDayGainEffect:         convertToString(raw.RealizedDayGainEffect),
+		RestrictedQuantity:            raw.RestrictedQuantity,
+		RealizedDayGain:               raw.RealizedDayGain,
+		RealizedDayGainEffect:         Effect(convertToString(raw.RealizedDayGainEffect)),
 		RealizedDayGainDate:           convertToString(raw.RealizedDayGainDate),
-		RealizedToday:                 convertToString(raw.RealizedToday),
-		RealizedTodayEffect:           convertToString(raw.RealizedTodayEffect),
+		RealizedToday:                 raw.RealizedToday,
+		RealizedTodayEffect:           Effect(convertToString(raw.RealizedTodayEffect)),
 		RealizedTodayDate:             convertToString(raw.RealizedTodayDate),
 		CreatedAt:                     convertToString(raw.CreatedAt),
 		UpdatedAt:                     convertToString(raw.UpdatedAt),
@@ -165,16 +179,21 @@ func convertPositionRaw(raw positionRaw) Position {
 // Returns a PositionsResponse containing a list of all open positions including
 // equities, options, futures, and other instruments.
 func (api *TastytradeAPI) GetPositions(accountNumber string) (PositionsResponse, error) {
+	return api.GetPositionsCtx(context.Background(), accountNumber)
+}
+
+// GetPositionsCtx is like GetPositions but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetPositionsCtx(ctx context.Context, accountNumber string) (PositionsResponse, error) {
 	url := fmt.Sprintf("%s/accounts/%s/positions", api.host, accountNumber)
-	data, err := api.fetchData(url)
+	data, err := api.fetchData(ctx, url)
 	if err != nil {
 		return PositionsResponse{}, err
 	}
 
 	// Extract context if available
-	var context string
-	if ctx, ok := data["context"].(string); ok {
-		context = ctx
+	var responseContext string
+	if value, ok := data["context"].(string); ok {
+		responseContext = value
 	}
 
 	// Extract items array
@@ -182,8 +201,13 @@ func (api *TastytradeAPI) GetPositions(accountNumber string) (PositionsResponse,
 	if dataValue, ok := data["data"]; ok {
 		if dataMap, ok := dataValue.(map[string]interface{}); ok {
 			if itemsArray, ok := dataMap["items"].([]interface{}); ok {
-				itemsData, _ := json.Marshal(itemsArray)
-				json.Unmarshal(itemsData, &items)
+				itemsData, err := json.Marshal(itemsArray)
+				if err != nil {
+					return PositionsResponse{}, err
+				}
+				if err := json.Unmarshal(itemsData, &items); err != nil {
+					return PositionsResponse{}, fmt.Errorf("failed to decode positions: %w", err)
+				}
 			}
 		}
 	}
@@ -195,7 +219,7 @@ func (api *TastytradeAPI) GetPositions(accountNumber string) (PositionsResponse,
 	}
 
 	response := PositionsResponse{
-		Context: context,
+		Context: responseContext,
 	}
 	response.Data.Items = positions
 
@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -31,7 +32,56 @@ func TestGetPositions(t *testing.T) {
 		t.Errorf("expected %s, got %s", "AAPL", resp.Data.Items[0].Symbol)
 	}
 
-	if resp.Data.Items[0].Quantity != "100" {
+	if resp.Data.Items[0].Quantity.String() != "100" {
 		t.Errorf("expected %s, got %s", "100", resp.Data.Items[0].Quantity)
 	}
 }
+
+func TestGetPositionsTolerantAmounts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"context": "test", "data": {"items": [
+			{"symbol": "AAPL", "quantity": "1,000", "close-price": ""},
+			{"symbol": "SPY", "quantity": 5, "close-price": "512.25"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	resp, err := api.GetPositions("123456")
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if len(resp.Data.Items) != 2 {
+		t.Fatalf("expected %d, got %d", 2, len(resp.Data.Items))
+	}
+
+	if resp.Data.Items[0].Quantity.String() != "1000" {
+		t.Errorf("expected %s, got %s", "1000", resp.Data.Items[0].Quantity)
+	}
+
+	if !resp.Data.Items[0].ClosePrice.IsZero() {
+		t.Errorf("expected %s, got %s", "0", resp.Data.Items[0].ClosePrice)
+	}
+
+	if resp.Data.Items[1].ClosePrice.String() != "512.25" {
+		t.Errorf("expected %s, got %s", "512.25", resp.Data.Items[1].ClosePrice)
+	}
+}
+
+func TestGetPositionsMalformedAmount(t *testing.T) {
+	for _, amount := range []string{`"lots"`, `"NaN"`} {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.Write([]byte(`{"context": "test", "data": {"items": [{"symbol": "AAPL", "quantity": "100"}, {"symbol": "SPY", "quantity": ` + amount + `}]}}`))
+		}))
+
+		api := NewTastytradeAPI(server.URL)
+		_, err := api.GetPositions("123456")
+		server.Close()
+
+		if !errors.Is(err, ErrInvalidDecimal) {
+			t.Errorf("%s: expected %v, got %v", amount, ErrInvalidDecimal, err)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package tastytrade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidQuoteValue is returned by QuoteData.Parse when a field holds a value that is not a valid number or
+// timestamp. The returned error wraps it together with the symbol and the offending field.
+var ErrInvalidQuoteValue = errors.New("invalid quote value")
+
+// Quote is the parsed form of QuoteData. Prices are exact Decimals; sizes, greeks and ratios are float64.
+// Numeric fields are nil when the server did not send a value (an empty string, a missing field or "NaN"),
+// so a missing value can be told apart from zero.
+type Quote struct {
+	Symbol             string    // Security symbol
+	InstrumentType     string    // Type: "Equity", "Equity Option", "Cryptocurrency", "Index", "Future", "Future Option"
+	UpdatedAt          time.Time // Time of the last update (zero if unknown)
+	Bid                *Decimal  // Bid price
+	BidSize            *float64  // Bid size
+	Ask                *Decimal  // Ask price
+	AskSize            *float64  // Ask size
+	Mid                *Decimal  // Mid price (average of bid and ask)
+	Mark               *Decimal  // Mark price
+	Last               *Decimal  // Last trade price
+	LastMkt            *Decimal  // Last market price
+	Beta               *float64  // Beta (for equities)
+	DividendAmount     *Decimal  // Dividend amount (for equities)
+	DividendFrequency  *float64  // Dividend frequency (for equities)
+	Open               *Decimal  // Opening price
+	DayHighPrice       *Decimal  // Day high price
+	DayLowPrice        *Decimal  // Day low price
+	Close              *Decimal  // Closing price
+	ClosePriceType     string    // Close price type (e.g., "Final", "Regular")
+	PrevClose          *Decimal  // Previous close price
+	PrevClosePriceType string    // Previous close price type
+	SummaryDate        string    // Summary date
+	PrevCloseDate      string    // Previous close date
+	LowLimitPrice      *Decimal  // Low limit price (for equities)
+	HighLimitPrice     *Decimal  // High limit price (for equities)
+	IsTradingHalted    bool      // Whether trading is halted
+	HaltStartTime      int64     // Halt start time (-1 if not halted)
+	HaltEndTime        int64     // Halt end time (-1 if not halted)
+	YearLowPrice       *Decimal  // Year low price
+	YearHighPrice      *Decimal  // Year high price
+	Volume             *int64    // Trading volume
+	OpenInterest       *int64    // Open interest (for options)
+	Volatility         *float64  // Implied volatility
+	Delta              *float64  // Delta
+	Gamma              *float64  // Gamma
+	Theta              *float64  // Theta
+	Vega               *float64  // Vega
+	Rho                *float64  // Rho
+	TheoPrice          *Decimal  // Theoretical price
+	DxMark             *Decimal  // DX mark price
+	TickSize           *Decimal  // Tick size
+}
+
+// Parse converts the string fields of q to a Quote. Malformed values are left nil in the returned Quote
+// and reported in the returned error, which wraps ErrInvalidQuoteValue once per offending field.
+func (q QuoteData) Parse() (Quote, error) {
+	p := quoteParser{symbol: q.Symbol}
+	quote := Quote{
+		Symbol:             q.Symbol,
+		InstrumentType:     q.InstrumentType,
+		UpdatedAt:          p.time("updated-at", q.UpdatedAt),
+		Bid:                p.decimal("bid", q.Bid),
+		BidSize:            p.float("bid-size", q.BidSize),
+		Ask:                p.decimal("ask", q.Ask),
+		AskSize:            p.float("ask-size", q.AskSize),
+		Mid:                p.decimal("mid", q.Mid),
+		Mark:               p.decimal("mark", q.Mark),
+		Last:               p.decimal("last", q.Last),
+		LastMkt:            p.decimal("last-mkt", q.LastMkt),
+		Beta:               p.float("beta", q.Beta),
+		DividendAmount:     p.decimal("dividend-amount", q.DividendAmount),
+		DividendFrequency:  p.float("dividend-frequency", q.DividendFrequency),
+		Open:               p.decimal("open", q.Open),
+		DayHighPrice:       p.decimal("day-high-price", q.DayHighPrice),
+		DayLowPrice:        p.decimal("day-low-price", q.DayLowPrice),
+		Close:              p.decimal("close", q.Close),
+		ClosePriceType:     q.ClosePriceType,
+		PrevClose:          p.decimal("prev-close", q.PrevClose),
+		PrevClosePriceType: q.PrevClosePriceType,
+		SummaryDate:        q.SummaryDate,
+		PrevCloseDate:      q.PrevCloseDate,
+		LowLimitPrice:      p.decimal("low-limit-price", q.LowLimitPrice),
+		HighLimitPrice:     p.decimal("high-limit-price", q.HighLimitPrice),
+		IsTradingHalted:    q.IsTradingHalted,
+		HaltStartTime:      q.HaltStartTime,
+		HaltEndTime:        q.HaltEndTime,
+		YearLowPrice:       p.decimal("year-low-price", q.YearLowPrice),
+		YearHighPrice:      p.decimal("year-high-price", q.YearHighPrice),
+		Volume:             p.integer("volume", q.Volume),
+		OpenInterest:       p.integer("open-interest", rawString(q.OpenInterest)),
+		Volatility:         p.float("volatility", q.Volatility),
+		Delta:              p.float("delta", q.Delta),
+		Gamma:              p.float("gamma", q.Gamma),
+		Theta:              p.float("theta", q.Theta),
+		Vega:               p.float("vega", q.Vega),
+		Rho:                p.float("rho", q.Rho),
+		TheoPrice:          p.decimal("theo-price", q.TheoPrice),
+		DxMark:             p.decimal("dx-mark", q.DxMark),
+		TickSize:           p.decimal("tick-size", q.TickSize),
+	}
+	return quote, errors.Join(p.errs...)
+}
+
+// quoteParser parses quote fields and collects the errors of malformed values.
+type quoteParser struct {
+	symbol string
+	errs   []error
+}
+
+// fail records a malformed value.
+func (p *quoteParser) fail(field, value string) {
+	p.errs = append(p.errs, fmt.Errorf("%w: %s %s %q", ErrInvalidQuoteValue, p.symbol, field, value))
+}
+
+// float parses a decimal value. Returns nil for missing values.
+func (p *quoteParser) float(field, value string) *float64 {
+	if value == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsInf(f, 0) {
+		p.fail(field, value)
+		return nil
+	}
+	if math.IsNaN(f) {
+		return nil
+	}
+	return &f
+}
+
+// decimal parses an exact decimal value. Returns nil for missing values.
+func (p *quoteParser) decimal(field, value string) *Decimal {
+	if value == "" || value == "NaN" {
+		return nil
+	}
+	d, err := ParseDecimal(value)
+	if err != nil {
+		p.fail(field, value)
+		return nil
+	}
+	return &d
+}
+
+// integer parses a whole number, which the server may send with a zero fraction (e.g., "1200.0").
+// Returns nil for missing values.
+func (p *quoteParser) integer(field, value string) *int64 {
+	f := p.float(field, value)
+	if f == nil {
+		return nil
+	}
+	// float64(math.MaxInt64) rounds up to 2^63, which no longer fits, so compare against the bounds exactly.
+	if *f != math.Trunc(*f) || *f < math.MinInt64 || *f >= -math.MinInt64 {
+		p.fail(field, value)
+		return nil
+	}
+	i := int64(*f)
+	return &i
+}
+
+// time parses an RFC 3339 timestamp. Returns the zero time for missing values.
+func (p *quoteParser) time(field, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		p.fail(field, value)
+		return time.Time{}
+	}
+	return t
+}
+
+// rawString returns a JSON number or string as its text. Returns "" for empty values and null.
+func rawString(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
@@ -0,0 +1,218 @@
+package tastytrade
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultQuoteBookInterval is how often a QuoteBook polls for new quotes.
+	defaultQuoteBookInterval = 5 * time.Second
+	// defaultQuoteMaxAge is how old a quote may get before QuoteBook considers it stale.
+	defaultQuoteMaxAge = time.Minute
+)
+
+// ErrQuoteBookStarted is returned by QuoteBook.Start when the book is already polling.
+var ErrQuoteBookStarted = errors.New("quote book already started")
+
+// QuoteBook keeps the latest quote for a fixed set of symbols in memory by polling GetQuotes,
+// so that several consumers can look quotes up without issuing REST calls of their own.
+// GetQuotes splits the symbols into batches of at most 100, the server's limit per request,
+// and fetches the batches in parallel.
+//
+//	book := api.NewQuoteBook(tastytrade.QuoteQueryParams{Equity: []string{"AAPL", "SPY"}})
+//	if err := book.Start(ctx); err != nil {
+//		...
+//	}
+//	defer book.Close()
+//	quote, ok := book.Get("AAPL")
+//
+// Settings must be changed before Start.
+type QuoteBook struct {
+	Interval time.Duration // Interval between refreshes (default and fallback for values <= 0: 5 seconds)
+	MaxAge   time.Duration // Age after which a quote is stale, based on its UpdatedAt (default: 1 minute)
+
+	// Updates receives every quote that changed during a refresh. It is buffered; updates are dropped
+	// when it is full, so consumers that fall behind should fall back to Get or Snapshot.
+	Updates chan QuoteData
+
+	api    *TastytradeAPI
+	params QuoteQueryParams
+	now    func() time.Time
+
+	mu      sync.RWMutex
+	quotes  map[string]QuoteData
+	err     error
+	started bool
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewQuoteBook creates a quote book for the given symbols, grouped by instrument type.
+func (api *TastytradeAPI) NewQuoteBook(params QuoteQueryParams) *QuoteBook {
+	return &QuoteBook{
+		Interval: defaultQuoteBookInterval,
+		MaxAge:   defaultQuoteMaxAge,
+		Updates:  make(chan QuoteData, streamerChannelSize),
+		api:      api,
+		params:   params,
+		now:      time.Now,
+		quotes:   make(map[string]QuoteData),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start loads the initial quotes and starts polling every Interval until Close is called.
+// ctx bounds the initial load only. Returns the error of the initial load if no quote could be fetched,
+// in which case Start may be called again, or ErrQuoteBookStarted if the book is already polling.
+func (b *QuoteBook) Start(ctx context.Context) error {
+	b.mu.Lock()
+	if b.started {
+		b.mu.Unlock()
+		return ErrQuoteBookStarted
+	}
+	b.started = true
+	b.mu.Unlock()
+
+	if err := b.Refresh(ctx); err != nil && len(b.Snapshot()) == 0 {
+		b.mu.Lock()
+		b.started = false
+		b.mu.Unlock()
+		return err
+	}
+	go b.pollLoop()
+	return nil
+}
+
+// Refresh fetches all quotes once with GetQuotes and updates the book. Batches that fail leave the previous
+// quotes of their symbols in place; the *QuotesError is returned and reported by Err until the next refresh.
+func (b *QuoteBook) Refresh(ctx context.Context) error {
+	params := b.params
+	response, err := b.api.GetQuotesCtx(ctx, &params, nil)
+	b.update(response.Data.Items)
+
+	b.mu.Lock()
+	b.err = err
+	b.mu.Unlock()
+	return err
+}
+
+// Get returns the latest quote for symbol and whether the book has one.
+func (b *QuoteBook) Get(symbol string) (QuoteData, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	quote, ok := b.quotes[symbol]
+	return quote, ok
+}
+
+// Snapshot returns a copy of all quotes in the book keyed by symbol.
+func (b *QuoteBook) Snapshot() map[string]QuoteData {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	snapshot := make(map[string]QuoteData, len(b.quotes))
+	for symbol, quote := range b.quotes {
+		snapshot[symbol] = quote
+	}
+	return snapshot
+}
+
+// IsStale reports whether the quote for symbol is missing, has no parseable UpdatedAt,
+// or was last updated more than MaxAge ago.
+func (b *QuoteBook) IsStale(symbol string) bool {
+	quote, ok := b.Get(symbol)
+	if !ok {
+		return true
+	}
+	updatedAt, err := time.Parse(time.RFC3339Nano, quote.UpdatedAt)
+	if err != nil {
+		return true
+	}
+	return b.now().Sub(updatedAt) > b.MaxAge
+}
+
+// Stale returns the requested symbols whose quotes are stale according to IsStale.
+func (b *QuoteBook) Stale() []string {
+	var stale []string
+	params := b.params
+	for _, symbols := range quoteParamFields(&params) {
+		for _, symbol := range *symbols {
+			if b.IsStale(symbol) {
+				stale = append(stale, symbol)
+			}
+		}
+	}
+	return stale
+}
+
+// Err returns the error of the most recent refresh, or nil if it succeeded.
+func (b *QuoteBook) Err() error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.err
+}
+
+// Done returns a channel that is closed when the book stops polling.
+func (b *QuoteBook) Done() <-chan struct{} {
+	return b.done
+}
+
+// Close stops polling. The book keeps serving the quotes it already has.
+func (b *QuoteBook) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.done)
+	})
+	return nil
+}
+
+// update stores quotes and notifies about the ones that changed.
+func (b *QuoteBook) update(quotes []QuoteData) {
+	var changed []QuoteData
+	b.mu.Lock()
+	for _, quote := range quotes {
+		if previous, ok := b.quotes[quote.Symbol]; ok && reflect.DeepEqual(previous, quote) {
+			continue
+		}
+		b.quotes[quote.Symbol] = quote
+		changed = append(changed, quote)
+	}
+	b.mu.Unlock()
+
+	for _, quote := range changed {
+		select {
+		case b.Updates <- quote:
+		default:
+		}
+	}
+}
+
+// pollLoop refreshes the book every Interval until Close is called.
+func (b *QuoteBook) pollLoop() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-b.done
+		cancel()
+	}()
+
+	interval := b.Interval
+	if interval <= 0 {
+		interval = defaultQuoteBookInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.done:
+			return
+		case <-ticker.C:
+			if err := b.Refresh(ctx); err != nil && ctx.Err() == nil {
+				b.api.log(ctx, slog.LevelWarn, "tastytrade quote book refresh failed", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package tastytrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newQuotesServer serves market-data/by-type with one quote per requested symbol. The bid of every
+// quote is the number of the request, so each refresh changes all quotes.
+func newQuotesServer(t *testing.T, updatedAt string) (*httptest.Server, *atomic.Int64) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/market-data/by-type" {
+			t.Errorf("expected %s, got %s", "/market-data/by-type", req.URL.Path)
+		}
+		n := requests.Add(1)
+
+		var items []QuoteData
+		for instrumentType, values := range req.URL.Query() {
+			for _, symbol := range strings.Split(values[0], ",") {
+				items = append(items, QuoteData{
+					Symbol:         symbol,
+					InstrumentType: instrumentType,
+					UpdatedAt:      updatedAt,
+					Bid:            fmt.Sprint(n),
+				})
+			}
+		}
+		json.NewEncoder(rw).Encode(map[string]interface{}{"data": map[string]interface{}{"items": items}})
+	}))
+	return server, &requests
+}
+
+func TestQuoteBook(t *testing.T) {
+	server, requests := newQuotesServer(t, "2024-09-10T14:30:00.000Z")
+	defer server.Close()
+
+	equities := make([]string, 150)
+	for i := range equities {
+		equities[i] = fmt.Sprintf("EQ%d", i)
+	}
+
+	api := NewTastytradeAPI(server.URL)
+	book := api.NewQuoteBook(QuoteQueryParams{Equity: equities, Index: []string{"SPX"}})
+	book.Interval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := book.Start(ctx); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer book.Close()
+
+	if len(book.Snapshot()) != 151 {
+		t.Errorf("expected %d, got %d", 151, len(book.Snapshot()))
+	}
+
+	quote, ok := book.Get("SPX")
+	if !ok || quote.InstrumentType != "index" {
+		t.Errorf("unexpected quote %+v", quote)
+	}
+
+	// The initial load needs two requests for 151 symbols; later refreshes change every quote.
+	for refreshed := false; !refreshed; {
+		select {
+		case update := <-book.Updates:
+			refreshed = update.Bid != "1" && update.Bid != "2"
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for update")
+		}
+	}
+
+	if requests.Load() < 3 {
+		t.Errorf("expected at least %d requests, got %d", 3, requests.Load())
+	}
+
+	if book.Err() != nil {
+		t.Errorf("expected nil, got %v", book.Err())
+	}
+}
+
+func TestQuoteBookStartOnce(t *testing.T) {
+	server, _ := newQuotesServer(t, "2024-09-10T14:30:00.000Z")
+	defer server.Close()
+
+	book := NewTastytradeAPI(server.URL).NewQuoteBook(QuoteQueryParams{Equity: []string{"AAPL"}})
+	book.Interval = 0
+
+	if err := book.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer book.Close()
+
+	if err := book.Start(context.Background()); err != ErrQuoteBookStarted {
+		t.Errorf("expected %v, got %v", ErrQuoteBookStarted, err)
+	}
+}
+
+func TestQuoteBookStaleness(t *testing.T) {
+	server, _ := newQuotesServer(t, "2024-09-10T14:30:00.000Z")
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	book := api.NewQuoteBook(QuoteQueryParams{Equity: []string{"AAPL"}})
+	book.now = func() time.Time { return time.Date(2024, 9, 10, 14, 30, 30, 0, time.UTC) }
+
+	if !book.IsStale("AAPL") {
+		t.Errorf("expected quote missing before refresh to be stale")
+	}
+
+	if err := book.Refresh(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if book.IsStale("AAPL") {
+		t.Errorf("expected 30 second old quote to be fresh")
+	}
+
+	book.now = func() time.Time { return time.Date(2024, 9, 10, 14, 32, 0, 0, time.UTC) }
+	if stale := book.Stale(); len(stale) != 1 || stale[0] != "AAPL" {
+		t.Errorf("expected [AAPL], got %v", stale)
+	}
+}
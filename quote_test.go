@@ -0,0 +1,112 @@
+package tastytrade
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQuoteDataParse(t *testing.T) {
+	var data QuoteData
+	err := json.Unmarshal([]byte(`{
+		"symbol": "SPY 250428P00355000",
+		"instrument-type": "Equity Option",
+		"updated-at": "2025-04-25T19:59:59.123Z",
+		"bid": "1.25",
+		"ask": "1.3",
+		"volume": "1200.0",
+		"open-interest": 4500,
+		"delta": "-0.25",
+		"vega": "NaN",
+		"theta": ""
+	}`), &data)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	quote, err := data.Parse()
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if quote.Bid == nil || quote.Bid.String() != "1.25" {
+		t.Errorf("expected %s, got %v", "1.25", quote.Bid)
+	}
+
+	if quote.Delta == nil || *quote.Delta != -0.25 {
+		t.Errorf("expected %f, got %v", -0.25, quote.Delta)
+	}
+
+	if quote.Volume == nil || *quote.Volume != 1200 {
+		t.Errorf("expected %d, got %v", 1200, quote.Volume)
+	}
+
+	if quote.OpenInterest == nil || *quote.OpenInterest != 4500 {
+		t.Errorf("expected %d, got %v", 4500, quote.OpenInterest)
+	}
+
+	if quote.Vega != nil || quote.Theta != nil || quote.Mark != nil {
+		t.Errorf("expected missing values to be nil, got %v %v %v", quote.Vega, quote.Theta, quote.Mark)
+	}
+
+	expected := time.Date(2025, 4, 25, 19, 59, 59, 123000000, time.UTC)
+	if !quote.UpdatedAt.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, quote.UpdatedAt)
+	}
+}
+
+func TestQuoteDataParseOpenInterestString(t *testing.T) {
+	quote, err := QuoteData{Symbol: "AAPL", OpenInterest: json.RawMessage(`"310"`)}.Parse()
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if quote.OpenInterest == nil || *quote.OpenInterest != 310 {
+		t.Errorf("expected %d, got %v", 310, quote.OpenInterest)
+	}
+}
+
+func TestQuoteDataParseMalformed(t *testing.T) {
+	quote, err := QuoteData{
+		Symbol:    "AAPL",
+		UpdatedAt: "yesterday",
+		Bid:       "1.2.3",
+		Ask:       "101.5",
+		Volume:    "12.5",
+	}.Parse()
+
+	if !errors.Is(err, ErrInvalidQuoteValue) {
+		t.Fatalf("expected %v, got %v", ErrInvalidQuoteValue, err)
+	}
+
+	for _, field := range []string{"updated-at", "bid", "volume"} {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("expected error to mention %s, got %v", field, err)
+		}
+	}
+
+	if quote.Bid != nil || quote.Volume != nil {
+		t.Errorf("expected malformed values to be nil, got %v %v", quote.Bid, quote.Volume)
+	}
+
+	if quote.Ask == nil || quote.Ask.String() != "101.5" {
+		t.Errorf("expected %s, got %v", "101.5", quote.Ask)
+	}
+}
+
+func TestQuoteDataParseIntegerBounds(t *testing.T) {
+	quote, err := QuoteData{Symbol: "AAPL", Volume: "9223372036854775808"}.Parse()
+	if !errors.Is(err, ErrInvalidQuoteValue) || quote.Volume != nil {
+		t.Errorf("expected %v, got %v (volume %v)", ErrInvalidQuoteValue, err, quote.Volume)
+	}
+
+	quote, err = QuoteData{Symbol: "AAPL", Volume: "-9223372036854775808"}.Parse()
+	if err != nil || quote.Volume == nil || *quote.Volume != math.MinInt64 {
+		t.Errorf("expected %d, got %v (%v)", int64(math.MinInt64), quote.Volume, err)
+	}
+}
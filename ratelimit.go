@@ -0,0 +1,206 @@
+package tastytrade
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndpointGroup identifies a family of API endpoints that can be rate limited independently.
+type EndpointGroup string
+
+const (
+	EndpointGroupMarketData  EndpointGroup = "market-data" // /market-data and /market-metrics endpoints
+	EndpointGroupInstruments EndpointGroup = "instruments" // /instruments and option chain endpoints
+	EndpointGroupAccounts    EndpointGroup = "accounts"    // /accounts and /customers endpoints
+	EndpointGroupOther       EndpointGroup = "other"       // Everything else (sessions, backtesting, ...)
+)
+
+const (
+	// rateLimitMinFactor is the lowest fraction of the configured rate a bucket adapts down to.
+	rateLimitMinFactor = 0.1
+	// rateLimitRecoveryInterval is how long a bucket must go without a 429 before its rate steps back up.
+	rateLimitRecoveryInterval = 10 * time.Second
+	// rateLimitRecoveryStep is the fraction of the configured rate restored at each recovery interval.
+	rateLimitRecoveryStep = 0.1
+)
+
+// endpointGroupForPath returns the endpoint group a request path belongs to.
+func endpointGroupForPath(path string) EndpointGroup {
+	switch {
+	case strings.HasPrefix(path, "/market-data"), strings.HasPrefix(path, "/market-metrics"):
+		return EndpointGroupMarketData
+	case strings.HasPrefix(path, "/instruments"), strings.HasPrefix(path, "/option-chains"), strings.HasPrefix(path, "/futures-option-chains"):
+		return EndpointGroupInstruments
+	case strings.HasPrefix(path, "/accounts"), strings.HasPrefix(path, "/customers"):
+		return EndpointGroupAccounts
+	default:
+		return EndpointGroupOther
+	}
+}
+
+// tokenBucket is a token bucket whose refill rate adapts down after rate limit responses.
+// It is not safe for concurrent use on its own; RateLimiter guards it with a mutex.
+type tokenBucket struct {
+	baseRate   float64   // Configured requests per second
+	rate       float64   // Current requests per second after adaptation
+	burst      float64   // Maximum number of stored tokens
+	tokens     float64   // Available tokens (negative when requests are queued)
+	last       time.Time // Time of the last refill
+	adjustedAt time.Time // Time of the last rate adjustment
+}
+
+func newTokenBucket(requestsPerSecond float64, burst int, now time.Time) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		baseRate: requestsPerSecond,
+		rate:     requestsPerSecond,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     now,
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if b.rate < b.baseRate && now.Sub(b.adjustedAt) >= rateLimitRecoveryInterval {
+		b.rate += b.baseRate * rateLimitRecoveryStep
+		if b.rate > b.baseRate {
+			b.rate = b.baseRate
+		}
+		b.adjustedAt = now
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// release returns a token taken by reserve that was not used.
+func (b *tokenBucket) release() {
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// penalize halves the current rate (down to a floor) and drains any stored burst.
+func (b *tokenBucket) penalize(now time.Time) {
+	b.rate /= 2
+	if minRate := b.baseRate * rateLimitMinFactor; b.rate < minRate {
+		b.rate = minRate
+	}
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
+	b.adjustedAt = now
+}
+
+// RateLimiter is a client-side token bucket limiter that is safe for concurrent use.
+// A single limiter can be shared by several TastytradeAPI clients. Every request consumes a token
+// from the global bucket and, when configured, from the bucket of its endpoint group.
+// After a 429 response the affected buckets halve their rate and gradually recover.
+type RateLimiter struct {
+	mu     sync.Mutex
+	global *tokenBucket
+	groups map[EndpointGroup]*tokenBucket
+	now    func() time.Time
+}
+
+// NewRateLimiter creates a rate limiter allowing requestsPerSecond on average with bursts of up to burst requests.
+// A requestsPerSecond of zero or less leaves requests unlimited unless group limits are added.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	limiter := &RateLimiter{
+		groups: make(map[EndpointGroup]*tokenBucket),
+		now:    time.Now,
+	}
+	if requestsPerSecond > 0 {
+		limiter.global = newTokenBucket(requestsPerSecond, burst, limiter.now())
+	}
+	return limiter
+}
+
+// SetGroupLimit sets an additional limit for requests in the given endpoint group.
+// A requestsPerSecond of zero or less removes the group limit.
+// Example: limiter.SetGroupLimit(tastytrade.EndpointGroupMarketData, 2, 1)
+func (l *RateLimiter) SetGroupLimit(group EndpointGroup, requestsPerSecond float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if requestsPerSecond <= 0 {
+		delete(l.groups, group)
+		return
+	}
+	l.groups[group] = newTokenBucket(requestsPerSecond, burst, l.now())
+}
+
+// Wait blocks until a request in the given endpoint group may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, group EndpointGroup) error {
+	l.mu.Lock()
+	now := l.now()
+	buckets := make([]*tokenBucket, 0, 2)
+	if l.global != nil {
+		buckets = append(buckets, l.global)
+	}
+	if bucket, ok := l.groups[group]; ok {
+		buckets = append(buckets, bucket)
+	}
+	var delay time.Duration
+	for _, bucket := range buckets {
+		if d := bucket.reserve(now); d > delay {
+			delay = d
+		}
+	}
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		for _, bucket := range buckets {
+			bucket.release()
+		}
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Throttle adapts the limiter down after the server rejected a request in the given group with 429.
+// The client calls this automatically; it is exported for callers that observe rate limiting elsewhere.
+func (l *RateLimiter) Throttle(group EndpointGroup) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if l.global != nil {
+		l.global.penalize(now)
+	}
+	if bucket, ok := l.groups[group]; ok {
+		bucket.penalize(now)
+	}
+}
+
+// SetRateLimiter sets the rate limiter applied to every outgoing request, including retries.
+// Passing nil disables client-side rate limiting, which is the default.
+// Example: api.SetRateLimiter(tastytrade.NewRateLimiter(5, 5))
+func (api *TastytradeAPI) SetRateLimiter(limiter *RateLimiter) {
+	api.rateLimiter = limiter
+}
@@ -0,0 +1,132 @@
+package tastytrade
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurstAndWait(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(10, 2)
+	limiter.now = func() time.Time { return now }
+	limiter.global.last = now
+
+	bucket := limiter.global
+	if d := bucket.reserve(now); d != 0 {
+		t.Errorf("expected %v, got %v", time.Duration(0), d)
+	}
+	if d := bucket.reserve(now); d != 0 {
+		t.Errorf("expected %v, got %v", time.Duration(0), d)
+	}
+	if d := bucket.reserve(now); d != 100*time.Millisecond {
+		t.Errorf("expected %v, got %v", 100*time.Millisecond, d)
+	}
+}
+
+func TestRateLimiterThrottleAndRecover(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(10, 1)
+	limiter.now = func() time.Time { return now }
+
+	limiter.Throttle(EndpointGroupMarketData)
+	if limiter.global.rate != 5 {
+		t.Errorf("expected %v, got %v", 5.0, limiter.global.rate)
+	}
+
+	for i := 0; i < 10; i++ {
+		limiter.Throttle(EndpointGroupMarketData)
+	}
+	if limiter.global.rate != 1 {
+		t.Errorf("expected %v, got %v", 1.0, limiter.global.rate)
+	}
+
+	limiter.global.reserve(now.Add(rateLimitRecoveryInterval))
+	if limiter.global.rate != 2 {
+		t.Errorf("expected %v, got %v", 2.0, limiter.global.rate)
+	}
+}
+
+func TestRateLimiterGroupLimit(t *testing.T) {
+	limiter := NewRateLimiter(0, 0)
+	limiter.SetGroupLimit(EndpointGroupMarketData, 20, 1)
+
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx, EndpointGroupMarketData); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected market data requests to be throttled, took %v", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 3; i++ {
+		limiter.Wait(ctx, EndpointGroupAccounts)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("expected account requests to be unthrottled, took %v", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	limiter := NewRateLimiter(1, 1)
+	limiter.Wait(context.Background(), EndpointGroupOther)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx, EndpointGroupOther); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestRateLimiterConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		rw.Write([]byte(`{"context": "test", "data": {"items": []}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	api.SetRateLimiter(NewRateLimiter(50, 5))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := api.GetPositions("123456"); err != nil {
+				t.Errorf("expected nil, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if requests != 10 {
+		t.Errorf("expected %d, got %d", 10, requests)
+	}
+}
+
+func TestEndpointGroupForPath(t *testing.T) {
+	tests := map[string]EndpointGroup{
+		"/market-data/by-type":       EndpointGroupMarketData,
+		"/instruments/equities/AAPL": EndpointGroupInstruments,
+		"/option-chains/SPY/nested":  EndpointGroupInstruments,
+		"/accounts/123/positions":    EndpointGroupAccounts,
+		"/sessions":                  EndpointGroupOther,
+	}
+	for path, want := range tests {
+		if got := endpointGroupForPath(path); got != want {
+			t.Errorf("%s: expected %s, got %s", path, want, got)
+		}
+	}
+}
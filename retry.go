@@ -0,0 +1,192 @@
+package tastytrade
+
+import (
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests.
+// Requests are retried on network errors and on the status codes listed in RetryableStatusCodes,
+// waiting a jittered exponential backoff (or the server's Retry-After value) between attempts.
+// Requests that place, replace, edit or cancel orders are never retried, because a retry after a lost
+// response could act on an order twice.
+type RetryPolicy struct {
+	MaxAttempts          int           // Total number of attempts including the first (values below 2 disable retries)
+	InitialBackoff       time.Duration // Backoff before the first retry
+	MaxBackoff           time.Duration // Upper bound for a single backoff
+	Jitter               float64       // Fraction of the backoff that is randomized (0 disables jitter, 1 is full jitter)
+	RetryableStatusCodes []int         // Status codes that trigger a retry (defaults to 429, 500, 502, 503 and 504)
+	RetryNonIdempotent   bool          // Whether POST and PATCH requests other than order requests are retried as well
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for most batch workloads:
+// up to 4 attempts with backoff starting at 500ms and capped at 10s, retrying idempotent requests only.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Jitter:         0.5,
+	}
+}
+
+// SetRetryPolicy sets the retry policy for subsequent requests.
+// Passing nil disables retries, which is the default.
+// Example: api.SetRetryPolicy(tastytrade.DefaultRetryPolicy())
+func (api *TastytradeAPI) SetRetryPolicy(policy *RetryPolicy) {
+	api.retryPolicy = policy
+}
+
+// defaultRetryableStatusCodes are retried when RetryPolicy.RetryableStatusCodes is empty.
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// allowsRequest reports whether req may be retried under the policy.
+func (p *RetryPolicy) allowsRequest(req *http.Request) bool {
+	if isOrderMutation(req) {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return p.RetryNonIdempotent
+}
+
+// isOrderMutation reports whether req places, replaces, edits or cancels an order or complex order.
+// Dry runs change nothing and are not order mutations.
+func isOrderMutation(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "orders" || segment == "complex-orders" {
+			return !(i+1 < len(segments) && segments[i+1] == "dry-run")
+		}
+	}
+	return false
+}
+
+// retryableStatus reports whether a response with the given status code should be retried.
+func (p *RetryPolicy) retryableStatus(statusCode int) bool {
+	codes := p.RetryableStatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryableStatusCodes
+	}
+	for _, code := range codes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (1 for the first retry).
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if p.Jitter > 0 && delay > 0 {
+		jitter := p.Jitter
+		if jitter > 1 {
+			jitter = 1
+		}
+		delay -= time.Duration(rand.Float64() * jitter * float64(delay))
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// send performs req, retrying according to the client's retry policy.
+// The returned response may have any status code; callers are responsible for checking it.
+func (api *TastytradeAPI) send(req *http.Request) (*http.Response, error) {
+	policy := api.retryPolicy
+	if policy == nil || policy.MaxAttempts < 2 || !policy.allowsRequest(req) {
+		return api.roundTrip(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := api.roundTrip(req)
+
+		lastAttempt := attempt >= policy.MaxAttempts
+		if err == nil && !policy.retryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+		// Only the caller's context ends the retries; an attempt cut short by the client's own
+		// timeout (which also reports context.DeadlineExceeded) is retried like any network error.
+		if err != nil && ctx.Err() != nil {
+			return nil, err
+		}
+		if lastAttempt || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		delay := policy.backoff(attempt)
+		var reason string
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+		}
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				delay = retryAfter
+			}
+			resp.Body.Close()
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		api.log(ctx, slog.LevelWarn, "retrying tastytrade request", "method", req.Method, "url", req.URL.String(), "attempt", attempt, "delay", delay, "reason", reason)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
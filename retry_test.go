@@ -0,0 +1,174 @@
+package tastytrade
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryOnServerError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts < 3 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"account-number": "123456"}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	api.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	resp, err := api.GetAccountTradingStatus("123456")
+
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected %d, got %d", 3, attempts)
+	}
+
+	if resp.Data.AccountNumber != "123456" {
+		t.Errorf("expected %s, got %s", "123456", resp.Data.AccountNumber)
+	}
+}
+
+func TestRetryAfterClientTimeout(t *testing.T) {
+	var attempts atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if attempts.Add(1) == 1 {
+			// Stall the first response until the client gives up on it.
+			select {
+			case <-req.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"account-number": "123456"}}`))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithTimeout(100*time.Millisecond))
+	api.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	resp, err := api.GetAccountTradingStatus("123456")
+
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if attempts.Load() != 2 {
+		t.Errorf("expected %d, got %d", 2, attempts.Load())
+	}
+
+	if resp.Data.AccountNumber != "123456" {
+		t.Errorf("expected %s, got %s", "123456", resp.Data.AccountNumber)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		rw.Header().Set("Retry-After", "0")
+		rw.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	api.SetRetryPolicy(&RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Hour})
+	_, err := api.GetPositions("123456")
+
+	if !IsRateLimited(err) {
+		t.Errorf("expected rate limited error, got %v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected %d, got %d", 2, attempts)
+	}
+}
+
+func TestRetrySkipsPostByDefault(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts < 2 {
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		rw.Write([]byte(`{"context": "test", "data": {"id": "bt-1"}}`))
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	api.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	_, err := api.SubmitBacktest(BacktestRequest{Symbol: "SPY"})
+
+	if err == nil || attempts != 1 {
+		t.Errorf("expected a single failed attempt, got %d attempts and error %v", attempts, err)
+	}
+
+	attempts = 0
+	api.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, RetryNonIdempotent: true})
+	resp, err := api.SubmitBacktest(BacktestRequest{Symbol: "SPY"})
+
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if resp.Data.ID != "bt-1" {
+		t.Errorf("expected %s, got %s", "bt-1", resp.Data.ID)
+	}
+}
+
+func TestRetrySkipsOrderMutations(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		rw.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithProductionWrites(true))
+	api.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, RetryNonIdempotent: true})
+
+	if _, err := api.CancelOrder("123456", 1001); err == nil || attempts != 1 {
+		t.Errorf("expected a single failed attempt, got %d attempts and error %v", attempts, err)
+	}
+
+	attempts = 0
+	if _, err := api.ReplaceOrder("123456", 1001, testOrder()); err == nil || attempts != 1 {
+		t.Errorf("expected a single failed attempt, got %d attempts and error %v", attempts, err)
+	}
+
+	attempts = 0
+	if _, err := api.PlaceOrder("123456", testOrder()); err == nil || attempts != 1 {
+		t.Errorf("expected a single failed attempt, got %d attempts and error %v", attempts, err)
+	}
+
+	attempts = 0
+	if _, err := api.DryRunOrder("123456", testOrder()); err == nil || attempts != 3 {
+		t.Errorf("expected 3 failed attempts, got %d attempts and error %v", attempts, err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	delay, ok := parseRetryAfter("3", now)
+	if !ok || delay != 3*time.Second {
+		t.Errorf("expected %v, got %v", 3*time.Second, delay)
+	}
+
+	delay, ok = parseRetryAfter(now.Add(5*time.Second).Format(http.TimeFormat), now)
+	if !ok || delay != 5*time.Second {
+		t.Errorf("expected %v, got %v", 5*time.Second, delay)
+	}
+
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Errorf("expected invalid Retry-After to be rejected")
+	}
+}
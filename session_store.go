@@ -0,0 +1,180 @@
+package tastytrade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Session holds the credentials needed to reuse a login across process runs.
+type Session struct {
+	Login         string    `json:"login"`                        // Username the session belongs to
+	Environment   string    `json:"environment"`                  // Name of the environment that issued the session
+	SessionToken  string    `json:"session-token"`                // Session token for API authentication
+	RememberToken string    `json:"remember-token,omitempty"`     // Remember token for password-less login, if any
+	ExpiresAt     time.Time `json:"session-expiration,omitempty"` // Session expiry reported at login (zero if unknown)
+}
+
+// SessionStore persists sessions between runs. Implementations must be safe to call
+// from the goroutine that uses the client; they do not need to be safe for concurrent use.
+type SessionStore interface {
+	// Load returns the stored session, or nil if none has been saved.
+	Load() (*Session, error)
+	// Save stores the session, replacing any previous one.
+	Save(session *Session) error
+	// Clear removes the stored session.
+	Clear() error
+}
+
+// FileSessionStore is a SessionStore that keeps the session in a JSON file readable only by the owner (0600).
+type FileSessionStore struct {
+	Path string // Location of the session file
+}
+
+// NewFileSessionStore creates a FileSessionStore writing to path.
+// Missing parent directories are created with 0700 permissions on Save.
+func NewFileSessionStore(path string) *FileSessionStore {
+	return &FileSessionStore{Path: path}
+}
+
+// Load reads the session file. A missing file is not an error and returns a nil session.
+func (s *FileSessionStore) Load() (*Session, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("invalid session file %s: %w", s.Path, err)
+	}
+	return &session, nil
+}
+
+// Save writes the session file atomically with 0600 permissions.
+func (s *FileSessionStore) Save(session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.Path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".session-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// Clear removes the session file. A missing file is not an error.
+func (s *FileSessionStore) Clear() error {
+	err := os.Remove(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// DefaultSessionFile returns the default location of the session file for an environment,
+// inside the user's cache directory (e.g., ~/.cache/tastytrade/production-session.json).
+func DefaultSessionFile(env Environment) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "tastytrade", env.Name+"-session.json"), nil
+}
+
+// currentSession returns the client's session in its persistable form.
+func (api *TastytradeAPI) currentSession() *Session {
+	api.authMu.RLock()
+	defer api.authMu.RUnlock()
+	return &Session{
+		Login:         api.username,
+		Environment:   api.environment.Name,
+		SessionToken:  api.authToken,
+		RememberToken: api.rememberToken,
+		ExpiresAt:     api.sessionExpiresAt,
+	}
+}
+
+// ResumeSession restores the session saved in the client's session store.
+// The stored session token is checked with ValidateSession unless it is already known to have expired;
+// if the API no longer accepts it, a new session is created from the stored remember token.
+// Returns false (with a nil error) when no usable session could be restored, in which case
+// the caller should log in with a password.
+func (api *TastytradeAPI) ResumeSession() (bool, error) {
+	return api.ResumeSessionCtx(context.Background())
+}
+
+// ResumeSessionCtx is like ResumeSession but carries ctx on the outgoing requests.
+func (api *TastytradeAPI) ResumeSessionCtx(ctx context.Context) (bool, error) {
+	if api.sessionStore == nil {
+		return false, nil
+	}
+
+	session, err := api.sessionStore.Load()
+	if err != nil || session == nil {
+		return false, err
+	}
+	if session.Environment != api.environment.Name || (session.SessionToken == "" && session.RememberToken == "") {
+		return false, nil
+	}
+
+	api.setSession(session.Login, AuthData{
+		SessionToken:  session.SessionToken,
+		RememberToken: session.RememberToken,
+	})
+	api.authMu.Lock()
+	api.sessionExpiresAt = session.ExpiresAt
+	api.authMu.Unlock()
+
+	if session.SessionToken != "" && !api.sessionExpired(time.Now()) {
+		_, err := api.ValidateSessionCtx(ctx)
+		if err == nil {
+			return true, nil
+		}
+		if !IsUnauthorized(err) {
+			return false, err
+		}
+	}
+
+	if session.RememberToken == "" {
+		api.setSession("", AuthData{})
+		return false, api.sessionStore.Clear()
+	}
+
+	if _, err := api.LoginWithRememberTokenCtx(ctx, session.Login, session.RememberToken); err != nil {
+		api.setSession("", AuthData{})
+		if IsUnauthorized(err) || IsValidationError(err) {
+			return false, api.sessionStore.Clear()
+		}
+		return false, err
+	}
+	return true, nil
+}
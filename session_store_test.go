@@ -0,0 +1,154 @@
+package tastytrade
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSessionStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "session.json")
+	store := NewFileSessionStore(path)
+
+	session, err := store.Load()
+	if err != nil || session != nil {
+		t.Errorf("expected no session, got %v, %v", session, err)
+	}
+
+	err = store.Save(&Session{Login: "testuser", Environment: "production", SessionToken: "token", RememberToken: "remember"})
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("expected %v, got %v", os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	session, err = store.Load()
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	if session.SessionToken != "token" || session.RememberToken != "remember" {
+		t.Errorf("unexpected session %+v", session)
+	}
+
+	if err := store.Clear(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+	if err := store.Clear(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
+
+func TestAuthenticateWithRememberMeSavesSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		if body["remember-me"] != true {
+			t.Errorf("expected remember-me to be true, got %v", body["remember-me"])
+		}
+		rw.Write([]byte(`{"context": "/sessions", "data": {"user": {"username": "testuser"}, "session-token": "testtoken", "remember-token": "remember1"}}`))
+	}))
+	defer server.Close()
+
+	store := NewFileSessionStore(filepath.Join(t.TempDir(), "session.json"))
+	api := New(WithHost(server.URL), WithSessionStore(store))
+	rememberToken, err := api.AuthenticateWithRememberMe("testuser", "testpassword")
+
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if rememberToken != "remember1" {
+		t.Errorf("expected %s, got %s", "remember1", rememberToken)
+	}
+
+	session, err := store.Load()
+	if err != nil || session == nil {
+		t.Fatalf("expected saved session, got %v, %v", session, err)
+	}
+
+	if session.SessionToken != "testtoken" || session.Login != "testuser" {
+		t.Errorf("unexpected session %+v", session)
+	}
+}
+
+func TestResumeSessionWithValidToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "storedtoken" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rw.Write([]byte(`{"context": "/sessions/validate", "data": {"username": "testuser"}}`))
+	}))
+	defer server.Close()
+
+	store := NewFileSessionStore(filepath.Join(t.TempDir(), "session.json"))
+	store.Save(&Session{Login: "testuser", Environment: "production", SessionToken: "storedtoken"})
+
+	api := New(WithHost(server.URL), WithSessionStore(store))
+	resumed, err := api.ResumeSession()
+
+	if err != nil || !resumed {
+		t.Errorf("expected resumed session, got %v, %v", resumed, err)
+	}
+
+	if api.authToken != "storedtoken" {
+		t.Errorf("expected %s, got %s", "storedtoken", api.authToken)
+	}
+}
+
+func TestResumeSessionWithRememberToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/sessions/validate":
+			rw.WriteHeader(http.StatusUnauthorized)
+		case "/sessions":
+			var body map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&body)
+			if body["remember-token"] != "remember1" {
+				t.Errorf("expected %s, got %v", "remember1", body["remember-token"])
+			}
+			rw.Write([]byte(`{"context": "/sessions", "data": {"session-token": "newtoken", "remember-token": "remember2"}}`))
+		}
+	}))
+	defer server.Close()
+
+	store := NewFileSessionStore(filepath.Join(t.TempDir(), "session.json"))
+	store.Save(&Session{Login: "testuser", Environment: "production", SessionToken: "expired", RememberToken: "remember1"})
+
+	api := New(WithHost(server.URL), WithSessionStore(store))
+	resumed, err := api.ResumeSession()
+
+	if err != nil || !resumed {
+		t.Errorf("expected resumed session, got %v, %v", resumed, err)
+	}
+
+	if api.authToken != "newtoken" {
+		t.Errorf("expected %s, got %s", "newtoken", api.authToken)
+	}
+
+	session, _ := store.Load()
+	if session.RememberToken != "remember2" {
+		t.Errorf("expected %s, got %s", "remember2", session.RememberToken)
+	}
+}
+
+func TestResumeSessionIgnoresOtherEnvironment(t *testing.T) {
+	store := NewFileSessionStore(filepath.Join(t.TempDir(), "session.json"))
+	store.Save(&Session{Login: "testuser", Environment: "sandbox", SessionToken: "sandboxtoken"})
+
+	api := New(WithSessionStore(store))
+	resumed, err := api.ResumeSession()
+
+	if err != nil || resumed {
+		t.Errorf("expected no resumed session, got %v, %v", resumed, err)
+	}
+}
@@ -2,11 +2,25 @@ package tastytrade
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
+const (
+	// challengeTokenHeader carries the two-factor challenge token issued by a rejected login.
+	challengeTokenHeader = "X-Tastyworks-Challenge-Token"
+	// otpHeader carries the one-time password answering a two-factor challenge.
+	otpHeader = "X-Tastyworks-OTP"
+)
+
+// errNoReauthCredentials is returned when a session expired and the client has neither
+// a remember token nor a password to log in again with.
+var errNoReauthCredentials = errors.New("no remember token or password available to re-authenticate")
+
 // User represents user information returned after authentication.
 type User struct {
 	Email       string `json:"email"`        // User's email address
@@ -18,8 +32,16 @@ type User struct {
 // AuthData represents authentication data returned by the Authenticate endpoint.
 // It contains user information and a session token for subsequent API requests.
 type AuthData struct {
-	User         User   `json:"user"`          // User information
-	SessionToken string `json:"session-token"` // Session token for API authentication
+	User              User   `json:"user"`                     // User information
+	SessionToken      string `json:"session-token"`            // Session token for API authentication
+	RememberToken     string `json:"remember-token,omitempty"` // Single-use token for logging in again without a password (only with remember-me)
+	SessionExpiration string `json:"session-expiration"`       // Session expiration timestamp (e.g., "2024-09-12T20:25:32.440Z")
+}
+
+// SessionValidationResponse represents the response structure returned by ValidateSession.
+type SessionValidationResponse struct {
+	Data    User   `json:"data"`    // User the session belongs to
+	Context string `json:"context"` // API context identifier
 }
 
 // AuthResponse represents the response structure returned by Authenticate.
@@ -33,31 +55,290 @@ type AuthResponse struct {
 // On success, the session token is stored in the API client for use in subsequent requests.
 // Returns an error if authentication fails.
 func (api *TastytradeAPI) Authenticate(username, password string) error {
-	authURL := fmt.Sprintf("%s/sessions", api.host)
-	authData := map[string]string{
+	return api.AuthenticateCtx(context.Background(), username, password)
+}
+
+// AuthenticateCtx is like Authenticate but carries ctx on the outgoing request.
+func (api *TastytradeAPI) AuthenticateCtx(ctx context.Context, username, password string) error {
+	_, err := api.login(ctx, map[string]interface{}{
 		"login":    username,
 		"password": password,
+	}, nil)
+	return err
+}
+
+// AuthenticateWithRememberMe authenticates with username and password and asks the API for a remember token.
+// On success, the session token is stored in the API client (and in the session store, if one is configured)
+// and the remember token is returned. The remember token can later be passed to LoginWithRememberToken
+// to obtain a new session without the password.
+func (api *TastytradeAPI) AuthenticateWithRememberMe(username, password string) (string, error) {
+	return api.AuthenticateWithRememberMeCtx(context.Background(), username, password)
+}
+
+// AuthenticateWithRememberMeCtx is like AuthenticateWithRememberMe but carries ctx on the outgoing request.
+func (api *TastytradeAPI) AuthenticateWithRememberMeCtx(ctx context.Context, username, password string) (string, error) {
+	authData, err := api.login(ctx, map[string]interface{}{
+		"login":       username,
+		"password":    password,
+		"remember-me": true,
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+	return authData.RememberToken, nil
+}
+
+// LoginWithRememberToken creates a new session from a remember token obtained from an earlier login.
+// Remember tokens are single-use: the API issues a replacement, which is returned and, when a session store
+// is configured, persisted together with the new session token.
+func (api *TastytradeAPI) LoginWithRememberToken(username, rememberToken string) (string, error) {
+	return api.LoginWithRememberTokenCtx(context.Background(), username, rememberToken)
+}
+
+// LoginWithRememberTokenCtx is like LoginWithRememberToken but carries ctx on the outgoing request.
+func (api *TastytradeAPI) LoginWithRememberTokenCtx(ctx context.Context, username, rememberToken string) (string, error) {
+	authData, err := api.login(ctx, map[string]interface{}{
+		"login":          username,
+		"remember-token": rememberToken,
+		"remember-me":    true,
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+	return authData.RememberToken, nil
+}
+
+// SubmitOTP finishes a login that was interrupted by a two-factor challenge.
+// challenge is the *ChallengeError returned by Authenticate, AuthenticateWithRememberMe or LoginWithRememberToken,
+// and otp is the one-time password the user received. The original login is retried with the code attached;
+// on success the session is installed exactly as for the original call and its remember token (if any) is returned.
+// Example:
+//
+//	err := api.Authenticate(username, password)
+//	var challenge *tastytrade.ChallengeError
+//	if errors.As(err, &challenge) {
+//		_, err = api.SubmitOTP(challenge, promptForCode())
+//	}
+func (api *TastytradeAPI) SubmitOTP(challenge *ChallengeError, otp string) (string, error) {
+	return api.SubmitOTPCtx(context.Background(), challenge, otp)
+}
+
+// SubmitOTPCtx is like SubmitOTP but carries ctx on the outgoing request.
+func (api *TastytradeAPI) SubmitOTPCtx(ctx context.Context, challenge *ChallengeError, otp string) (string, error) {
+	if challenge == nil || challenge.credentials == nil {
+		return "", errors.New("no pending two-factor challenge")
+	}
+
+	header := http.Header{}
+	header.Set(otpHeader, otp)
+	if challenge.ChallengeToken != "" {
+		header.Set(challengeTokenHeader, challenge.ChallengeToken)
+	}
+
+	authData, err := api.login(ctx, challenge.credentials, header)
+	if err != nil {
+		return "", err
 	}
-	authBody, err := json.Marshal(authData)
+	return authData.RememberToken, nil
+}
+
+// RememberToken returns the remember token of the current session, if any.
+func (api *TastytradeAPI) RememberToken() string {
+	api.authMu.RLock()
+	defer api.authMu.RUnlock()
+	return api.rememberToken
+}
+
+// SessionExpiresAt returns when the current session expires, as reported by the API at login.
+// The zero time is returned when the expiry is unknown.
+func (api *TastytradeAPI) SessionExpiresAt() time.Time {
+	api.authMu.RLock()
+	defer api.authMu.RUnlock()
+	return api.sessionExpiresAt
+}
+
+// ValidateSession checks whether the current session token is still accepted by the API.
+// Returns the user the session belongs to, or an error satisfying IsUnauthorized if the session has expired.
+func (api *TastytradeAPI) ValidateSession() (SessionValidationResponse, error) {
+	return api.ValidateSessionCtx(context.Background())
+}
+
+// ValidateSessionCtx is like ValidateSession but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ValidateSessionCtx(ctx context.Context) (SessionValidationResponse, error) {
+	urlVal := fmt.Sprintf("%s/sessions/validate", api.host)
+	req, err := http.NewRequestWithContext(ctx, "POST", urlVal, nil)
+	if err != nil {
+		return SessionValidationResponse{}, err
+	}
+
+	resp, err := api.do(req)
+	if err != nil {
+		return SessionValidationResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	var response SessionValidationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return SessionValidationResponse{}, err
+	}
+	return response, nil
+}
+
+// Logout destroys the current session on the server and forgets it locally,
+// including any remember token, stored password and saved session.
+func (api *TastytradeAPI) Logout() error {
+	return api.LogoutCtx(context.Background())
+}
+
+// LogoutCtx is like Logout but carries ctx on the outgoing request.
+func (api *TastytradeAPI) LogoutCtx(ctx context.Context) error {
+	urlVal := fmt.Sprintf("%s/sessions", api.host)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", urlVal, nil)
 	if err != nil {
 		return err
 	}
 
-	resp, err := api.httpClient.Post(authURL, "application/json", bytes.NewReader(authBody))
+	resp, err := api.do(req)
+	if err != nil && !IsUnauthorized(err) {
+		return err
+	}
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	api.authMu.Lock()
+	api.authToken = ""
+	api.rememberToken = ""
+	api.password = ""
+	api.sessionExpiresAt = time.Time{}
+	api.authMu.Unlock()
+
+	if api.sessionStore != nil {
+		return api.sessionStore.Clear()
+	}
+	return nil
+}
+
+// setSession installs a new session on the client.
+func (api *TastytradeAPI) setSession(login string, authData AuthData) {
+	expiresAt, _ := time.Parse(time.RFC3339, authData.SessionExpiration)
+
+	api.authMu.Lock()
+	defer api.authMu.Unlock()
+	api.authToken = authData.SessionToken
+	api.rememberToken = authData.RememberToken
+	api.sessionExpiresAt = expiresAt
+	api.username = login
+}
+
+// sessionExpired reports whether the current session is known to have expired.
+func (api *TastytradeAPI) sessionExpired(now time.Time) bool {
+	api.authMu.RLock()
+	defer api.authMu.RUnlock()
+	return !api.sessionExpiresAt.IsZero() && !now.Before(api.sessionExpiresAt)
+}
+
+// reauthenticate logs in again using the remember token or the stored password.
+// staleToken is the token that was rejected; if another goroutine already replaced it,
+// no new login is performed.
+func (api *TastytradeAPI) reauthenticate(ctx context.Context, staleToken string) error {
+	api.reauthMu.Lock()
+	defer api.reauthMu.Unlock()
+
+	api.authMu.RLock()
+	currentToken, rememberToken, login, password := api.authToken, api.rememberToken, api.username, api.password
+	api.authMu.RUnlock()
+
+	if currentToken != staleToken {
+		return nil
+	}
+
+	var err error
+	if rememberToken != "" {
+		if _, err = api.LoginWithRememberTokenCtx(ctx, login, rememberToken); err == nil {
+			return nil
+		}
+	}
+	if password != "" {
+		return api.AuthenticateCtx(ctx, login, password)
+	}
 	if err != nil {
 		return err
 	}
+	return errNoReauthCredentials
+}
+
+// isChallengeResponse reports whether a rejected login asks for a one-time password
+// rather than failing outright.
+func isChallengeResponse(resp *http.Response, apiErr *APIError) bool {
+	if resp.StatusCode != http.StatusForbidden {
+		return false
+	}
+	if resp.Header.Get(challengeTokenHeader) != "" {
+		return true
+	}
+	switch apiErr.Code {
+	case "device_challenge_required", "otp_required", "invalid_otp":
+		return true
+	}
+	return false
+}
+
+// login posts the given credentials to /sessions, installs the resulting session on the client
+// and persists it to the session store when one is configured.
+// header holds extra request headers, such as the one-time password answering a two-factor challenge.
+func (api *TastytradeAPI) login(ctx context.Context, credentials map[string]interface{}, header http.Header) (AuthData, error) {
+	authURL := fmt.Sprintf("%s/sessions", api.host)
+	authBody, err := json.Marshal(credentials)
+	if err != nil {
+		return AuthData{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", authURL, bytes.NewReader(authBody))
+	if err != nil {
+		return AuthData{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := api.send(req)
+	if err != nil {
+		return AuthData{}, err
+	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("authentication failed: status code %d", resp.StatusCode)
+		apiErr := newAPIError(req, resp)
+		if isChallengeResponse(resp, apiErr) {
+			return AuthData{}, &ChallengeError{
+				ChallengeToken: resp.Header.Get(challengeTokenHeader),
+				Err:            apiErr,
+				credentials:    credentials,
+			}
+		}
+		return AuthData{}, fmt.Errorf("authentication failed: %w", apiErr)
 	}
 
 	authResponse := AuthResponse{}
 	if err := json.NewDecoder(resp.Body).Decode(&authResponse); err != nil {
-		return err
+		return AuthData{}, err
 	}
 
-	api.authToken = authResponse.Data.SessionToken
-	return nil
+	login, _ := credentials["login"].(string)
+	api.setSession(login, authResponse.Data)
+	if password, ok := credentials["password"].(string); ok && api.autoReauth {
+		api.authMu.Lock()
+		api.password = password
+		api.authMu.Unlock()
+	}
+
+	if api.sessionStore != nil {
+		if err := api.sessionStore.Save(api.currentSession()); err != nil {
+			return authResponse.Data, fmt.Errorf("failed to save session: %w", err)
+		}
+	}
+
+	return authResponse.Data, nil
 }
@@ -1,9 +1,12 @@
 package tastytrade
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestAuthenticate(t *testing.T) {
@@ -23,3 +26,195 @@ func TestAuthenticate(t *testing.T) {
 		t.Errorf("expected %s, got %s", "testtoken", api.authToken)
 	}
 }
+
+func TestAuthenticateRecordsSessionExpiration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"context": "/sessions", "data": {"session-token": "testtoken", "session-expiration": "2024-09-12T20:25:32.440Z"}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	if err := api.Authenticate("testuser", "testpassword"); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	expected := time.Date(2024, 9, 12, 20, 25, 32, 440000000, time.UTC)
+	if !api.SessionExpiresAt().Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, api.SessionExpiresAt())
+	}
+}
+
+func TestValidateSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "POST" || req.URL.Path != "/sessions/validate" {
+			t.Errorf("got: %s %s, want: POST /sessions/validate", req.Method, req.URL.Path)
+		}
+		if req.Header.Get("Authorization") != "validtoken" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rw.Write([]byte(`{"context": "/sessions/validate", "data": {"email": "test@example.com", "username": "testuser"}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	api.authToken = "validtoken"
+	resp, err := api.ValidateSession()
+
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if resp.Data.Username != "testuser" {
+		t.Errorf("expected %s, got %s", "testuser", resp.Data.Username)
+	}
+
+	api.authToken = "expiredtoken"
+	if _, err := api.ValidateSession(); !IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != "DELETE" || req.URL.Path != "/sessions" {
+			t.Errorf("got: %s %s, want: DELETE /sessions", req.Method, req.URL.Path)
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	api.authToken = "testtoken"
+	api.rememberToken = "remember"
+
+	if err := api.Logout(); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if api.authToken != "" || api.RememberToken() != "" {
+		t.Errorf("expected session to be cleared")
+	}
+}
+
+func TestAutoReauthenticateOnUnauthorized(t *testing.T) {
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/sessions":
+			logins++
+			rw.Write([]byte(fmt.Sprintf(`{"context": "/sessions", "data": {"session-token": "token%d"}}`, logins)))
+		default:
+			if req.Header.Get("Authorization") != "token2" {
+				rw.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			rw.Write([]byte(`{"context": "test", "data": {"items": [{"symbol": "AAPL"}]}}`))
+		}
+	}))
+	defer server.Close()
+
+	api := New(WithHost(server.URL), WithAutoReauthenticate())
+	if err := api.Authenticate("testuser", "testpassword"); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	resp, err := api.GetPositions("123456")
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if logins != 2 {
+		t.Errorf("expected %d, got %d", 2, logins)
+	}
+
+	if len(resp.Data.Items) != 1 {
+		t.Errorf("expected %d, got %d", 1, len(resp.Data.Items))
+	}
+}
+
+func TestNoReauthenticateByDefault(t *testing.T) {
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/sessions" {
+			logins++
+			rw.Write([]byte(`{"context": "/sessions", "data": {"session-token": "testtoken"}}`))
+			return
+		}
+		rw.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	api.Authenticate("testuser", "testpassword")
+	_, err := api.GetPositions("123456")
+
+	if !IsUnauthorized(err) {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+
+	if logins != 1 {
+		t.Errorf("expected %d, got %d", 1, logins)
+	}
+
+	if api.password != "" {
+		t.Errorf("expected password not to be kept without auto re-authentication")
+	}
+}
+
+func TestAuthenticateTwoFactorChallenge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("X-Tastyworks-OTP") == "" {
+			rw.Header().Set("X-Tastyworks-Challenge-Token", "challengetoken")
+			rw.WriteHeader(http.StatusForbidden)
+			rw.Write([]byte(`{"error": {"code": "device_challenge_required", "message": "Device challenge required"}}`))
+			return
+		}
+		if req.Header.Get("X-Tastyworks-OTP") != "123456" || req.Header.Get("X-Tastyworks-Challenge-Token") != "challengetoken" {
+			t.Errorf("unexpected challenge headers %v", req.Header)
+		}
+		rw.Write([]byte(`{"context": "/sessions", "data": {"session-token": "testtoken", "remember-token": "remembertoken"}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	_, err := api.AuthenticateWithRememberMe("testuser", "testpassword")
+
+	if !IsChallengeRequired(err) {
+		t.Fatalf("expected challenge error, got %v", err)
+	}
+
+	var challenge *ChallengeError
+	errors.As(err, &challenge)
+	if challenge.ChallengeToken != "challengetoken" {
+		t.Errorf("expected %s, got %s", "challengetoken", challenge.ChallengeToken)
+	}
+
+	rememberToken, err := api.SubmitOTP(challenge, "123456")
+	if err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if rememberToken != "remembertoken" {
+		t.Errorf("expected %s, got %s", "remembertoken", rememberToken)
+	}
+
+	if api.authToken != "testtoken" {
+		t.Errorf("expected %s, got %s", "testtoken", api.authToken)
+	}
+}
+
+func TestAuthenticateInvalidCredentialsIsNotChallenge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusForbidden)
+		rw.Write([]byte(`{"error": {"code": "invalid_credentials", "message": "Invalid login, please check your username and password"}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	err := api.Authenticate("testuser", "wrongpassword")
+
+	if err == nil || IsChallengeRequired(err) {
+		t.Errorf("expected authentication error, got %v", err)
+	}
+}
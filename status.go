@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -52,8 +53,13 @@ type TradingStatusResponse struct {
 // Returns a TradingStatusResponse containing detailed information about account permissions,
 // restrictions, trading capabilities, and status flags.
 func (api *TastytradeAPI) GetAccountTradingStatus(accountNumber string) (TradingStatusResponse, error) {
+	return api.GetAccountTradingStatusCtx(context.Background(), accountNumber)
+}
+
+// GetAccountTradingStatusCtx is like GetAccountTradingStatus but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetAccountTradingStatusCtx(ctx context.Context, accountNumber string) (TradingStatusResponse, error) {
 	url := fmt.Sprintf("%s/accounts/%s/trading-status", api.host, accountNumber)
-	data, err := api.fetchData(url)
+	data, err := api.fetchData(ctx, url)
 	if err != nil {
 		return TradingStatusResponse{}, err
 	}
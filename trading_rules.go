@@ -0,0 +1,158 @@
+package tastytrade
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidQuantity is returned when an order quantity is not positive or has more decimal places
+// than the instrument allows. The returned error wraps it together with the offending leg.
+var ErrInvalidQuantity = errors.New("invalid order quantity")
+
+// TradingRules describes the price increments and quantity precision an instrument trades in.
+// Build one from instrument data with EquityTradingRules, EquityOptionTradingRules, FutureTradingRules
+// or CryptocurrencyTradingRules, then pass proposed orders through Normalize before sending them:
+//
+//	rules := tastytrade.EquityOptionTradingRules(equity.Data)
+//	order, err := rules.Normalize(order)
+type TradingRules struct {
+	PriceTicks        []Tick // Threshold-based tick sizes for single-leg orders
+	SpreadTicks       []Tick // Threshold-based tick sizes for multi-leg orders (PriceTicks when empty)
+	QuantityPrecision int    // Number of decimal places allowed in quantities (0 for whole contracts or shares)
+}
+
+// EquityTradingRules returns the trading rules for shares of the given equity. The rules allow whole shares
+// only, whatever IsFractionalQuantityEligible says; for a fractional-quantity eligible equity, apply the
+// precision from GetQuantityDecimalPrecisions with WithQuantityPrecision.
+func EquityTradingRules(equity EquityData) TradingRules {
+	return TradingRules{PriceTicks: equity.TickSizes}
+}
+
+// EquityOptionTradingRules returns the trading rules for options on the given equity,
+// e.g. $0.01 below $3.00 and $0.05 above for most equity options.
+func EquityOptionTradingRules(equity EquityData) TradingRules {
+	return TradingRules{PriceTicks: equity.OptionTickSizes}
+}
+
+// FutureTradingRules returns the trading rules for the given future contract.
+// Multi-leg orders (calendar spreads) use the contract's spread tick sizes.
+func FutureTradingRules(future Future) TradingRules {
+	rules := TradingRules{
+		PriceTicks:  ticksFromTickSizes(future.TickSizes),
+		SpreadTicks: ticksFromTickSizes(future.SpreadTickSizes),
+	}
+	if len(rules.PriceTicks) == 0 && future.TickSize != "" {
+		rules.PriceTicks = []Tick{{Value: future.TickSize}}
+	}
+	return rules
+}
+
+// CryptocurrencyTradingRules returns the trading rules for the given cryptocurrency.
+// The quantity precision is taken from the first routable destination venue.
+func CryptocurrencyTradingRules(crypto Cryptocurrency) TradingRules {
+	rules := TradingRules{}
+	if crypto.TickSize != "" {
+		rules.PriceTicks = []Tick{{Value: crypto.TickSize}}
+	}
+	for _, venue := range crypto.DestinationVenueSymbols {
+		if venue.Routable {
+			rules.QuantityPrecision = venue.MaxQuantityPrecision
+			break
+		}
+	}
+	return rules
+}
+
+// WithQuantityPrecision returns a copy of the rules using the quantity precision returned by GetQuantityDecimalPrecisions.
+func (r TradingRules) WithQuantityPrecision(precision QuantityDecimalPrecision) TradingRules {
+	r.QuantityPrecision = precision.Value
+	return r
+}
+
+// TickSize returns the price increment that applies to price. Each tick applies below its threshold,
+// and the tick without a threshold applies to all higher prices. Returns 0 when no ticks are known.
+func (r TradingRules) TickSize(price Decimal, spread bool) Decimal {
+	ticks := r.PriceTicks
+	if spread && len(r.SpreadTicks) > 0 {
+		ticks = r.SpreadTicks
+	}
+
+	price = price.Abs()
+	var last Decimal
+	for _, tick := range ticks {
+		value, err := ParseDecimal(tick.Value)
+		if err != nil || value.Sign() <= 0 {
+			continue
+		}
+		last = value
+		if tick.Threshold == "" {
+			return value
+		}
+		threshold, err := ParseDecimal(tick.Threshold)
+		if err == nil && price.Cmp(threshold) < 0 {
+			return value
+		}
+	}
+	return last
+}
+
+// RoundPrice snaps price to the nearest valid tick. Prices are returned unchanged when no ticks are known.
+func (r TradingRules) RoundPrice(price Decimal, spread bool) Decimal {
+	tick := r.TickSize(price, spread)
+	if tick.IsZero() {
+		return price
+	}
+	// Crossing below a threshold can only make the tick finer, so the rounded price stays valid.
+	return price.roundToStep(tick)
+}
+
+// ValidateQuantity checks that quantity is positive and has no more decimal places than QuantityPrecision.
+// Returns an error wrapping ErrInvalidQuantity otherwise.
+func (r TradingRules) ValidateQuantity(quantity Decimal) error {
+	if quantity.Sign() <= 0 {
+		return fmt.Errorf("%w: %s is not positive", ErrInvalidQuantity, quantity)
+	}
+	if !quantity.Round(int32(r.QuantityPrecision)).Equal(quantity) {
+		if r.QuantityPrecision == 0 {
+			return fmt.Errorf("%w: %s is fractional", ErrInvalidQuantity, quantity)
+		}
+		return fmt.Errorf("%w: %s has more than %d decimal places", ErrInvalidQuantity, quantity, r.QuantityPrecision)
+	}
+	return nil
+}
+
+// Normalize validates the leg quantities of order and snaps its limit price and stop trigger to valid ticks.
+// Orders with more than one leg use the spread tick sizes. Returns the adjusted order, or an error wrapping
+// ErrInvalidQuantity when a leg quantity is not allowed.
+func (r TradingRules) Normalize(order Order) (Order, error) {
+	for _, leg := range order.Legs {
+		// Notional market orders specify a value instead of leg quantities.
+		if leg.Quantity.IsZero() && order.OrderType == OrderTypeNotionalMarket {
+			continue
+		}
+		if err := r.ValidateQuantity(leg.Quantity); err != nil {
+			return Order{}, fmt.Errorf("leg %s: %w", leg.Symbol, err)
+		}
+	}
+
+	spread := len(order.Legs) > 1
+	if !order.Price.IsZero() {
+		order.Price = r.RoundPrice(order.Price, spread)
+	}
+	if !order.StopTrigger.IsZero() {
+		order.StopTrigger = r.RoundPrice(order.StopTrigger, spread)
+	}
+	return order, nil
+}
+
+// ticksFromTickSizes converts futures tick sizes to threshold-based ticks.
+func ticksFromTickSizes(tickSizes []TickSize) []Tick {
+	if len(tickSizes) == 0 {
+		return nil
+	}
+	ticks := make([]Tick, 0, len(tickSizes))
+	for _, tickSize := range tickSizes {
+		ticks = append(ticks, Tick{Threshold: tickSize.Threshold, Value: tickSize.Value})
+	}
+	return ticks
+}
@@ -0,0 +1,115 @@
+package tastytrade
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTradingRulesRoundPrice(t *testing.T) {
+	rules := EquityOptionTradingRules(EquityData{
+		OptionTickSizes: []Tick{{Threshold: "3.0", Value: "0.01"}, {Value: "0.05"}},
+	})
+
+	tests := []struct {
+		price    string
+		expected string
+	}{
+		{"1.234", "1.23"},
+		{"2.999", "3.00"},
+		{"3.07", "3.05"},
+		{"3.08", "3.10"},
+		{"12.324", "12.30"},
+		{"-3.08", "-3.10"},
+	}
+	for _, test := range tests {
+		if got := rules.RoundPrice(MustParseDecimal(test.price), false); got.String() != test.expected {
+			t.Errorf("expected %s, got %s", test.expected, got)
+		}
+	}
+}
+
+func TestFutureTradingRulesUseSpreadTicks(t *testing.T) {
+	rules := FutureTradingRules(Future{
+		TickSizes:       []TickSize{{Value: "0.25"}},
+		SpreadTickSizes: []TickSize{{Value: "0.05"}},
+	})
+
+	if got := rules.RoundPrice(MustParseDecimal("5012.6"), false); got.String() != "5012.50" {
+		t.Errorf("expected %s, got %s", "5012.50", got)
+	}
+
+	if got := rules.RoundPrice(MustParseDecimal("12.62"), true); got.String() != "12.60" {
+		t.Errorf("expected %s, got %s", "12.60", got)
+	}
+
+	rules = FutureTradingRules(Future{TickSize: "0.5"})
+	if got := rules.TickSize(MustParseDecimal("100"), false); got.String() != "0.5" {
+		t.Errorf("expected %s, got %s", "0.5", got)
+	}
+}
+
+func TestTradingRulesValidateQuantity(t *testing.T) {
+	rules := EquityTradingRules(EquityData{TickSizes: []Tick{{Value: "0.01"}}})
+
+	if err := rules.ValidateQuantity(MustParseDecimal("10")); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if err := rules.ValidateQuantity(MustParseDecimal("0.5")); !errors.Is(err, ErrInvalidQuantity) {
+		t.Errorf("expected %v, got %v", ErrInvalidQuantity, err)
+	}
+
+	if err := rules.ValidateQuantity(MustParseDecimal("0")); !errors.Is(err, ErrInvalidQuantity) {
+		t.Errorf("expected %v, got %v", ErrInvalidQuantity, err)
+	}
+
+	rules = rules.WithQuantityPrecision(QuantityDecimalPrecision{Value: 5})
+	if err := rules.ValidateQuantity(MustParseDecimal("0.12345")); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+
+	if err := rules.ValidateQuantity(MustParseDecimal("0.123456")); !errors.Is(err, ErrInvalidQuantity) {
+		t.Errorf("expected %v, got %v", ErrInvalidQuantity, err)
+	}
+}
+
+func TestCryptocurrencyTradingRules(t *testing.T) {
+	rules := CryptocurrencyTradingRules(Cryptocurrency{
+		TickSize: "0.01",
+		DestinationVenueSymbols: []DestinationVenueSymbols{
+			{Routable: false, MaxQuantityPrecision: 2},
+			{Routable: true, MaxQuantityPrecision: 8},
+		},
+	})
+
+	if rules.QuantityPrecision != 8 {
+		t.Errorf("expected %d, got %d", 8, rules.QuantityPrecision)
+	}
+
+	if err := rules.ValidateQuantity(MustParseDecimal("0.00012345")); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
+
+func TestTradingRulesNormalize(t *testing.T) {
+	rules := EquityOptionTradingRules(EquityData{
+		OptionTickSizes: []Tick{{Threshold: "3.0", Value: "0.01"}, {Value: "0.05"}},
+	})
+
+	order := testOrder()
+	order.Price = MustParseDecimal("4.12")
+	normalized, err := rules.Normalize(order)
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if !normalized.Price.Equal(MustParseDecimal("4.10")) {
+		t.Errorf("expected %s, got %s", "4.10", normalized.Price)
+	}
+
+	order.Legs[0].Quantity = MustParseDecimal("1.5")
+	if _, err := rules.Normalize(order); !errors.Is(err, ErrInvalidQuantity) {
+		t.Errorf("expected %v, got %v", ErrInvalidQuantity, err)
+	}
+}
@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -9,24 +10,34 @@ import (
 // Transaction represents a single transaction in an account.
 // It contains details about trades, deposits, withdrawals, fees, and other account activities.
 type Transaction struct {
-	ID                 int    `json:"id"`                   // Transaction ID
-	AccountNumber      string `json:"account-number"`       // Account number
-	Symbol             string `json:"symbol"`               // Symbol of the instrument
-	InstrumentType     string `json:"instrument-type"`      // Type of instrument (Equity, Option, Future, etc.)
-	UnderlyingSymbol   string `json:"underlying-symbol"`    // Underlying symbol for derivatives
-	TransactionType    string `json:"transaction-type"`     // Type of transaction (e.g., "Money Movement", "Trade", "Fee", "Deposit")
-	TransactionSubType string `json:"transaction-sub-type"` // Subtype of transaction (e.g., "Withdrawal", "Deposit", "Fee")
-	Description        string `json:"description"`          // Transaction description
-	Action             string `json:"action"`               // Action taken (e.g., "Buy", "Sell", or empty string for non-trade transactions)
-	Quantity           string `json:"quantity"`             // Transaction quantity
-	Price              string `json:"price"`                // Transaction price
-	ExecutedAt         string `json:"executed-at"`          // Execution timestamp
-	TransactionDate    string `json:"transaction-date"`     // Transaction date
-	Value              string `json:"value"`                // Transaction value
-	ValueEffect        string `json:"value-effect"`         // Value effect: "Debit" or "Credit" (example: "Debit")
-	NetValue           string `json:"net-value"`            // Net transaction value
-	NetValueEffect     string `json:"net-value-effect"`     // Net value effect: "Debit" or "Credit" (example: "Debit")
-	IsEstimatedFee     bool   `json:"is-estimated-fee"`     // Whether fee is estimated
+	ID                 int     `json:"id"`                   // Transaction ID
+	AccountNumber      string  `json:"account-number"`       // Account number
+	Symbol             string  `json:"symbol"`               // Symbol of the instrument
+	InstrumentType     string  `json:"instrument-type"`      // Type of instrument (Equity, Option, Future, etc.)
+	UnderlyingSymbol   string  `json:"underlying-symbol"`    // Underlying symbol for derivatives
+	TransactionType    string  `json:"transaction-type"`     // Type of transaction (e.g., "Money Movement", "Trade", "Fee", "Deposit")
+	TransactionSubType string  `json:"transaction-sub-type"` // Subtype of transaction (e.g., "Withdrawal", "Deposit", "Fee")
+	Description        string  `json:"description"`          // Transaction description
+	Action             string  `json:"action"`               // Action taken (e.g., "Buy", "Sell", or empty string for non-trade transactions)
+	Quantity           Decimal `json:"quantity"`             // Transaction quantity
+	Price              Decimal `json:"price"`                // Transaction price
+	ExecutedAt         string  `json:"executed-at"`          // Execution timestamp
+	TransactionDate    string  `json:"transaction-date"`     // Transaction date
+	Value              Decimal `json:"value"`                // Transaction value
+	ValueEffect        Effect  `json:"value-effect"`         // Value effect: "Debit", "Credit" or "None" (example: "Debit")
+	NetValue           Decimal `json:"net-value"`            // Net transaction value
+	NetValueEffect     Effect  `json:"net-value-effect"`     // Net value effect: "Debit", "Credit" or "None" (example: "Debit")
+	IsEstimatedFee     bool    `json:"is-estimated-fee"`     // Whether fee is estimated
+}
+
+// SignedValue returns Value signed by ValueEffect: negative for a Debit, positive for a Credit and 0 for None.
+func (t Transaction) SignedValue() Decimal {
+	return t.ValueEffect.Apply(t.Value)
+}
+
+// SignedNetValue returns NetValue signed by NetValueEffect: negative for a Debit, positive for a Credit and 0 for None.
+func (t Transaction) SignedNetValue() Decimal {
+	return t.NetValueEffect.Apply(t.NetValue)
 }
 
 // TransactionResponse represents the response structure returned by GetTransaction.
@@ -82,6 +93,11 @@ type TransactionQueryParams struct {
 // symbol, instrument type, action, and other criteria.
 // Returns a TransactionsResponse containing a paginated list of matching transactions.
 func (api *TastytradeAPI) GetTransactions(accountNumber string, params *TransactionQueryParams) (TransactionsResponse, error) {
+	return api.GetTransactionsCtx(context.Background(), accountNumber, params)
+}
+
+// GetTransactionsCtx is like GetTransactions but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetTransactionsCtx(ctx context.Context, accountNumber string, params *TransactionQueryParams) (TransactionsResponse, error) {
 	urlVal := fmt.Sprintf("%s/accounts/%s/transactions", api.host, accountNumber)
 
 	if params != nil {
@@ -131,7 +147,7 @@ func (api *TastytradeAPI) GetTransactions(accountNumber string, params *Transact
 		urlVal = fmt.Sprintf("%s?%s", urlVal, queryParams.Encode())
 	}
 
-	data, err := api.fetchData(urlVal)
+	data, err := api.fetchData(ctx, urlVal)
 	if err != nil {
 		return TransactionsResponse{}, err
 	}
@@ -153,8 +169,13 @@ func (api *TastytradeAPI) GetTransactions(accountNumber string, params *Transact
 // GetTransaction retrieves a specific transaction by ID for a specific account.
 // Returns a TransactionResponse containing detailed information about the transaction.
 func (api *TastytradeAPI) GetTransaction(accountNumber string, transactionID string) (TransactionResponse, error) {
+	return api.GetTransactionCtx(context.Background(), accountNumber, transactionID)
+}
+
+// GetTransactionCtx is like GetTransaction but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetTransactionCtx(ctx context.Context, accountNumber string, transactionID string) (TransactionResponse, error) {
 	urlVal := fmt.Sprintf("%s/accounts/%s/transactions/%s", api.host, accountNumber, transactionID)
-	data, err := api.fetchData(urlVal)
+	data, err := api.fetchData(ctx, urlVal)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
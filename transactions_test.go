@@ -66,6 +66,32 @@ func TestGetTransaction(t *testing.T) {
 	}
 }
 
+func TestTransactionSignedValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"context": "test", "data": {"id": 1, "value": "250.00", "value-effect": "Debit", "net-value": "251.14", "net-value-effect": "Debit"}}`))
+	}))
+	defer server.Close()
+
+	api := NewTastytradeAPI(server.URL)
+	resp, err := api.GetTransaction("123", "1")
+
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if resp.Data.NetValueEffect != EffectDebit {
+		t.Errorf("expected %s, got %s", EffectDebit, resp.Data.NetValueEffect)
+	}
+
+	if value := resp.Data.SignedValue(); value.String() != "-250.00" {
+		t.Errorf("expected %s, got %s", "-250.00", value.String())
+	}
+
+	if netValue := resp.Data.SignedNetValue(); netValue.String() != "-251.14" {
+		t.Errorf("expected %s, got %s", "-251.14", netValue.String())
+	}
+}
+
 func TestGetTransactionsPagination(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		rw.Write([]byte(`{"api-version": "1.0", "context": "test", "data": {"items": [{"id": 1, "account-number": "123", "symbol": "AAPL", "instrument-type": "equity-option"}]}, "pagination": {"per-page": 1, "total-items": 10}}`))
@@ -1,6 +1,7 @@
 package tastytrade
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -38,11 +39,16 @@ type GetWarrantResult struct {
 // all available warrants are returned.
 // Returns a ListWarrantsResult containing matching warrant instruments.
 func (api *TastytradeAPI) ListWarrants(symbols ...string) (ListWarrantsResult, error) {
+	return api.ListWarrantsCtx(context.Background(), symbols...)
+}
+
+// ListWarrantsCtx is like ListWarrants but carries ctx on the outgoing request.
+func (api *TastytradeAPI) ListWarrantsCtx(ctx context.Context, symbols ...string) (ListWarrantsResult, error) {
 	url := fmt.Sprintf("%s/instruments/warrants", api.host)
 	if len(symbols) > 0 {
 		url = fmt.Sprintf("%s?symbols=%s", url, strings.Join(symbols, ","))
 	}
-	data, err := api.fetchInstrumentData(url)
+	data, err := api.fetchInstrumentData(ctx, url)
 	if err != nil {
 		return ListWarrantsResult{}, err
 	}
@@ -65,8 +71,13 @@ func (api *TastytradeAPI) ListWarrants(symbols ...string) (ListWarrantsResult, e
 // Returns a GetWarrantResult containing detailed information about the warrant
 // including market, description, and trading status.
 func (api *TastytradeAPI) GetWarrant(symbol string) (GetWarrantResult, error) {
+	return api.GetWarrantCtx(context.Background(), symbol)
+}
+
+// GetWarrantCtx is like GetWarrant but carries ctx on the outgoing request.
+func (api *TastytradeAPI) GetWarrantCtx(ctx context.Context, symbol string) (GetWarrantResult, error) {
 	url := fmt.Sprintf("%s/instruments/warrants/%s", api.host, symbol)
-	data, err := api.fetchInstrumentData(url)
+	data, err := api.fetchInstrumentData(ctx, url)
 	if err != nil {
 		return GetWarrantResult{}, err
 	}
@@ -0,0 +1,540 @@
+package tastytrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// defaultMinReconnectDelay is the delay before the first reconnect attempt.
+	defaultMinReconnectDelay = 500 * time.Millisecond
+	// defaultMaxReconnectDelay caps the exponential backoff between reconnect attempts.
+	defaultMaxReconnectDelay = 30 * time.Second
+	// defaultHandshakeTimeout bounds how long the server may take to answer the handshake.
+	defaultHandshakeTimeout = 10 * time.Second
+	// defaultWriteTimeout bounds how long a single write may block on a stalled connection.
+	defaultWriteTimeout = 10 * time.Second
+	// closeFrameTimeout bounds how long Close waits to send the close frame.
+	closeFrameTimeout = time.Second
+	// sessionEventBufferSize is the buffer size of WebsocketSession.Events.
+	sessionEventBufferSize = 16
+)
+
+var (
+	// ErrSessionClosed is returned by WebsocketSession methods after Close.
+	ErrSessionClosed = errors.New("websocket session closed")
+	// ErrSessionDisconnected is returned by WebsocketSession.Send while the session is reconnecting.
+	ErrSessionDisconnected = errors.New("websocket session disconnected")
+)
+
+// SessionEventType identifies a WebsocketSession lifecycle event.
+type SessionEventType string
+
+const (
+	SessionConnected    SessionEventType = "connected"    // The first connection was established
+	SessionDisconnected SessionEventType = "disconnected" // The connection was lost; reconnecting
+	SessionReconnected  SessionEventType = "reconnected"  // The connection was restored; messages may have been missed
+)
+
+// SessionEvent reports a change in the connection state of a WebsocketSession.
+// A SessionReconnected event marks a gap in the stream: anything sent by the server while disconnected
+// was lost, so consumers should refresh their state over REST (e.g., GetPositions, GetAccountBalances).
+type SessionEvent struct {
+	Type     SessionEventType // Event type
+	Attempt  int              // Reconnect attempts needed (SessionReconnected only)
+	Downtime time.Duration    // Time spent disconnected (SessionReconnected only)
+	Err      error            // Error that broke the connection (SessionDisconnected only)
+}
+
+// WebsocketConn is a websocket connection handed to WebsocketSession hooks.
+// Writes are serialized, so hooks may write while the keepalive loop is running.
+type WebsocketConn struct {
+	conn         *websocket.Conn
+	writeMu      *sync.Mutex
+	writeTimeout time.Duration // Maximum time for a single write (0 disables the limit)
+}
+
+// WriteJSON sends v as a JSON text message. It fails if the write does not complete within the
+// session's WriteTimeout.
+func (c *WebsocketConn) WriteJSON(v interface{}) error {
+	return c.writeJSONCtx(context.Background(), v)
+}
+
+// writeJSONCtx is like WriteJSON but also gives up when ctx is done. An interrupted write leaves the
+// connection unusable, so the read loop fails and the session reconnects.
+func (c *WebsocketConn) writeJSONCtx(ctx context.Context, v interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	deadline, hasDeadline := ctx.Deadline()
+	if c.writeTimeout > 0 {
+		if timeout := time.Now().Add(c.writeTimeout); !hasDeadline || timeout.Before(deadline) {
+			deadline = timeout
+		}
+	}
+	c.conn.SetWriteDeadline(deadline)
+	interrupted := make(chan struct{})
+	stop := context.AfterFunc(ctx, func() {
+		c.conn.SetWriteDeadline(time.Now())
+		close(interrupted)
+	})
+	err := c.conn.WriteJSON(v)
+	if !stop() {
+		<-interrupted
+	}
+	c.conn.SetWriteDeadline(time.Time{})
+
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// ReadJSON reads the next message into v. It must only be called from the Handshake hook;
+// afterwards the session's read loop owns the connection.
+func (c *WebsocketConn) ReadJSON(v interface{}) error {
+	return c.conn.ReadJSON(v)
+}
+
+// WebsocketSession is a websocket connection that heals itself. It detects dropped connections and
+// missed keepalives, reconnects with exponential backoff, re-runs the authentication and handshake hooks,
+// replays registered subscriptions and reports every reconnect on Events.
+// The account and DXLink streamers are built on it; it can also drive other websocket protocols.
+// Hooks and settings must be set before Start.
+type WebsocketSession struct {
+	URL                  string        // Websocket URL to dial (may be replaced by Authenticate)
+	KeepaliveInterval    time.Duration // Interval between Keepalive calls (0 disables keepalives)
+	ReadTimeout          time.Duration // Reconnect when nothing was received for this long (0 disables the check)
+	HandshakeTimeout     time.Duration // Maximum time for the Handshake hook to complete (default: 10s; 0 disables the limit)
+	WriteTimeout         time.Duration // Maximum time for a single write (default: 10s; 0 disables the limit)
+	MinReconnectDelay    time.Duration // Delay before the first reconnect attempt (default: 500ms)
+	MaxReconnectDelay    time.Duration // Maximum delay between reconnect attempts (default: 30s)
+	MaxReconnectAttempts int           // Attempts per outage before giving up (0 retries forever)
+
+	// Authenticate runs before every dial, e.g. to fetch a fresh token. It returns the URL to dial;
+	// an empty URL keeps the current one.
+	Authenticate func(ctx context.Context) (string, error)
+	// Handshake runs after every dial, before subscriptions are replayed.
+	Handshake func(ctx context.Context, conn *WebsocketConn) error
+	// Keepalive sends a keepalive message; it is called every KeepaliveInterval.
+	Keepalive func(ctx context.Context, conn *WebsocketConn) error
+	// Handle is called from the read loop with every message received after the handshake.
+	// Returning an error drops the connection and reconnects.
+	Handle func(message []byte) error
+
+	// Events reports connection state changes. It is buffered; when it is full, SessionConnected and
+	// SessionDisconnected events are dropped, while SessionReconnected events are held back and delivered
+	// as soon as there is room. Reconnects that pile up meanwhile are merged into one event.
+	Events chan SessionEvent
+
+	dialer *websocket.Dialer
+	logger func(ctx context.Context, level slog.Level, msg string, args ...any)
+
+	mu            sync.Mutex
+	conn          *WebsocketConn
+	subscriptions []sessionSubscription
+	pendingGap    *SessionEvent // SessionReconnected event waiting for room on Events
+	sendingGaps   bool          // Whether deliverGaps is running
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+	done      chan struct{}
+	err       error
+}
+
+// sessionSubscription is a subscription replayed after every reconnect.
+type sessionSubscription struct {
+	key    string
+	replay func(ctx context.Context, conn *WebsocketConn) error
+}
+
+// NewWebsocketSession creates a session for the given URL that logs through the client's logger.
+// Configure its hooks, then call Start.
+func (api *TastytradeAPI) NewWebsocketSession(url string) *WebsocketSession {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WebsocketSession{
+		URL:               url,
+		MinReconnectDelay: defaultMinReconnectDelay,
+		MaxReconnectDelay: defaultMaxReconnectDelay,
+		HandshakeTimeout:  defaultHandshakeTimeout,
+		WriteTimeout:      defaultWriteTimeout,
+		Events:            make(chan SessionEvent, sessionEventBufferSize),
+		dialer:            websocket.DefaultDialer,
+		logger:            api.log,
+		ctx:               ctx,
+		cancel:            cancel,
+		done:              make(chan struct{}),
+	}
+}
+
+// Start establishes the first connection and starts the read loop, which reconnects as needed.
+// ctx bounds the first connection attempt only; use Close to stop the session.
+func (s *WebsocketSession) Start(ctx context.Context) error {
+	conn, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+	s.emit(SessionEvent{Type: SessionConnected})
+	go s.run(conn)
+	return nil
+}
+
+// Subscribe registers a subscription under key, replacing any subscription with the same key.
+// replay sends the subscription; it runs immediately with ctx when connected and again after every reconnect.
+func (s *WebsocketSession) Subscribe(ctx context.Context, key string, replay func(ctx context.Context, conn *WebsocketConn) error) error {
+	s.mu.Lock()
+	replaced := false
+	for i, subscription := range s.subscriptions {
+		if subscription.key == key {
+			s.subscriptions[i].replay = replay
+			replaced = true
+		}
+	}
+	if !replaced {
+		s.subscriptions = append(s.subscriptions, sessionSubscription{key: key, replay: replay})
+	}
+	conn := s.conn
+	s.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	return replay(ctx, conn)
+}
+
+// Unsubscribe removes the subscription registered under key so it is no longer replayed.
+func (s *WebsocketSession) Unsubscribe(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, subscription := range s.subscriptions {
+		if subscription.key == key {
+			s.subscriptions = append(s.subscriptions[:i], s.subscriptions[i+1:]...)
+			return
+		}
+	}
+}
+
+// Send writes v as JSON on the current connection.
+// Returns ErrSessionDisconnected while reconnecting and ErrSessionClosed after Close.
+func (s *WebsocketSession) Send(v interface{}) error {
+	return s.SendCtx(context.Background(), v)
+}
+
+// SendCtx is like Send but returns ctx.Err() once ctx is done, aborting a write in progress.
+// An aborted write leaves the connection unusable, so the session reconnects.
+func (s *WebsocketSession) SendCtx(ctx context.Context, v interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case <-s.done:
+		return ErrSessionClosed
+	default:
+	}
+
+	s.mu.Lock()
+	conn := s.conn
+	s.mu.Unlock()
+
+	if conn == nil {
+		return ErrSessionDisconnected
+	}
+	return conn.writeJSONCtx(ctx, v)
+}
+
+// Done returns a channel that is closed when the session stops, either through Close or because
+// reconnecting failed MaxReconnectAttempts times.
+func (s *WebsocketSession) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the error that stopped the session, or nil if it is running or was closed with Close.
+func (s *WebsocketSession) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close closes the connection and stops reconnecting.
+func (s *WebsocketSession) Close() error {
+	return s.stop(nil)
+}
+
+// stop stops the session, recording err as the reason.
+func (s *WebsocketSession) stop(err error) error {
+	var closeErr error
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		s.err = err
+		conn := s.conn
+		s.conn = nil
+		s.mu.Unlock()
+
+		s.cancel()
+		close(s.done)
+		if conn != nil {
+			// A writer stuck on a dead connection holds writeMu; skip the close frame rather than wait
+			// for it; closing the connection below unblocks the writer.
+			if conn.writeMu.TryLock() {
+				conn.conn.SetWriteDeadline(time.Now().Add(closeFrameTimeout))
+				conn.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				conn.writeMu.Unlock()
+			}
+			closeErr = conn.conn.Close()
+		}
+	})
+	return closeErr
+}
+
+// connect authenticates, dials, runs the handshake and replays subscriptions.
+func (s *WebsocketSession) connect(ctx context.Context) (*WebsocketConn, error) {
+	if s.Authenticate != nil {
+		url, err := s.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if url != "" {
+			s.URL = url
+		}
+	}
+
+	rawConn, _, err := s.dialer.DialContext(ctx, s.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", s.URL, err)
+	}
+	conn := &WebsocketConn{conn: rawConn, writeMu: &sync.Mutex{}, writeTimeout: s.WriteTimeout}
+
+	// Closing the connection is the only way to abort a blocked read or write, so cancelling ctx
+	// (or closing the session) closes it until the connection is handed over to the read loop.
+	stopAbort := context.AfterFunc(ctx, func() { rawConn.Close() })
+	defer stopAbort()
+
+	deadline, hasDeadline := ctx.Deadline()
+	if s.HandshakeTimeout > 0 {
+		if timeout := time.Now().Add(s.HandshakeTimeout); !hasDeadline || timeout.Before(deadline) {
+			deadline, hasDeadline = timeout, true
+		}
+	}
+	if hasDeadline {
+		rawConn.SetReadDeadline(deadline)
+	}
+	if s.Handshake != nil {
+		if err := s.Handshake(ctx, conn); err != nil {
+			rawConn.Close()
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("websocket handshake failed: %w", err)
+		}
+	}
+	rawConn.SetReadDeadline(time.Time{})
+
+	s.mu.Lock()
+	select {
+	case <-s.done:
+		s.mu.Unlock()
+		rawConn.Close()
+		return nil, ErrSessionClosed
+	default:
+	}
+	s.conn = conn
+	subscriptions := append([]sessionSubscription(nil), s.subscriptions...)
+	s.mu.Unlock()
+
+	for _, subscription := range subscriptions {
+		if err := subscription.replay(ctx, conn); err != nil {
+			s.detach(conn)
+			return nil, fmt.Errorf("failed to replay subscription %s: %w", subscription.key, err)
+		}
+	}
+	if !stopAbort() {
+		s.detach(conn)
+		return nil, ctx.Err()
+	}
+	return conn, nil
+}
+
+// detach closes conn and forgets it if it is still the current connection.
+func (s *WebsocketSession) detach(conn *WebsocketConn) {
+	s.mu.Lock()
+	if s.conn == conn {
+		s.conn = nil
+	}
+	s.mu.Unlock()
+	conn.conn.Close()
+}
+
+// run reads from conn and reconnects whenever the connection fails, until the session stops.
+func (s *WebsocketSession) run(conn *WebsocketConn) {
+	for {
+		err := s.serve(conn)
+		s.detach(conn)
+
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+
+		s.log(slog.LevelWarn, "tastytrade websocket disconnected", "url", s.URL, "error", err)
+		s.emit(SessionEvent{Type: SessionDisconnected, Err: err})
+
+		disconnectedAt := time.Now()
+		conn, err = s.reconnect()
+		if err != nil {
+			s.stop(err)
+			return
+		}
+		s.log(slog.LevelInfo, "tastytrade websocket reconnected", "url", s.URL, "downtime", time.Since(disconnectedAt))
+	}
+}
+
+// serve runs the keepalive loop and reads messages from conn until it fails.
+func (s *WebsocketSession) serve(conn *WebsocketConn) error {
+	stopKeepalive := make(chan struct{})
+	defer close(stopKeepalive)
+	if s.Keepalive != nil && s.KeepaliveInterval > 0 {
+		go s.keepaliveLoop(conn, stopKeepalive)
+	}
+
+	for {
+		if s.ReadTimeout > 0 {
+			conn.conn.SetReadDeadline(time.Now().Add(s.ReadTimeout))
+		}
+		_, message, err := conn.conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		if s.Handle != nil {
+			if err := s.Handle(message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// keepaliveLoop calls Keepalive every KeepaliveInterval until stop is closed.
+// A failed keepalive closes the connection, which makes the read loop reconnect.
+func (s *WebsocketSession) keepaliveLoop(conn *WebsocketConn, stop <-chan struct{}) {
+	ticker := time.NewTicker(s.KeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := s.Keepalive(s.ctx, conn); err != nil {
+				conn.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// reconnect retries connect with exponential backoff until it succeeds, the session is closed,
+// or MaxReconnectAttempts is exhausted.
+func (s *WebsocketSession) reconnect() (*WebsocketConn, error) {
+	disconnectedAt := time.Now()
+	delay := s.MinReconnectDelay
+	for attempt := 1; ; attempt++ {
+		timer := time.NewTimer(delay)
+		select {
+		case <-s.done:
+			timer.Stop()
+			return nil, ErrSessionClosed
+		case <-timer.C:
+		}
+
+		conn, err := s.connect(s.ctx)
+		if err == nil {
+			s.emitGap(SessionEvent{Type: SessionReconnected, Attempt: attempt, Downtime: time.Since(disconnectedAt)})
+			return conn, nil
+		}
+		if errors.Is(err, ErrSessionClosed) {
+			return nil, err
+		}
+		s.log(slog.LevelWarn, "tastytrade websocket reconnect failed", "url", s.URL, "attempt", attempt, "error", err)
+
+		if s.MaxReconnectAttempts > 0 && attempt >= s.MaxReconnectAttempts {
+			return nil, fmt.Errorf("giving up after %d reconnect attempts: %w", attempt, err)
+		}
+		delay *= 2
+		if delay > s.MaxReconnectDelay {
+			delay = s.MaxReconnectDelay
+		}
+	}
+}
+
+// emit delivers event on Events without blocking. The event is dropped when Events is full
+// or a SessionReconnected event is still waiting to be delivered, which keeps events in order.
+func (s *WebsocketSession) emit(event SessionEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sendingGaps {
+		return
+	}
+	select {
+	case s.Events <- event:
+	default:
+	}
+}
+
+// emitGap delivers a SessionReconnected event on Events. Unlike emit it never drops the event:
+// if Events is full, the event is queued for deliverGaps, merged with any reconnect already waiting.
+func (s *WebsocketSession) emitGap(event SessionEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pendingGap != nil {
+		s.pendingGap.Attempt += event.Attempt
+		s.pendingGap.Downtime += event.Downtime
+		return
+	}
+	if !s.sendingGaps {
+		select {
+		case s.Events <- event:
+			return
+		default:
+		}
+	}
+	s.pendingGap = &event
+	if !s.sendingGaps {
+		s.sendingGaps = true
+		go s.deliverGaps()
+	}
+}
+
+// deliverGaps sends queued SessionReconnected events as Events drains, until the queue is empty
+// or the session stops.
+func (s *WebsocketSession) deliverGaps() {
+	for {
+		s.mu.Lock()
+		event := s.pendingGap
+		s.pendingGap = nil
+		if event == nil {
+			s.sendingGaps = false
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+
+		select {
+		case s.Events <- *event:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// log writes to the logger of the client that created the session, if any.
+func (s *WebsocketSession) log(level slog.Level, msg string, args ...any) {
+	if s.logger != nil {
+		s.logger(context.Background(), level, msg, args...)
+	}
+}
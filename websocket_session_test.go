@@ -0,0 +1,418 @@
+package tastytrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// newSessionServer starts a websocket server that reports every message it receives as "<connection>:<message>".
+// handle runs for each connection after the upgrade with the connection number (starting at 1).
+func newSessionServer(t *testing.T, handle func(n int64, conn *websocket.Conn)) (*httptest.Server, <-chan string) {
+	messages := make(chan string, 64)
+	upgrader := websocket.Upgrader{}
+	var connections atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(rw, req, nil)
+		if err != nil {
+			t.Errorf("expected nil, got %v", err)
+			return
+		}
+		defer conn.Close()
+
+		n := connections.Add(1)
+		if handle != nil {
+			handle(n, conn)
+		}
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			messages <- fmt.Sprintf("%d:%s", n, strings.TrimSpace(string(message)))
+		}
+	}))
+	return server, messages
+}
+
+// newTestSession creates a session for server with a handshake that sends "hello" and fast reconnects.
+func newTestSession(server *httptest.Server) *WebsocketSession {
+	session := NewTastytradeAPI(server.URL).NewWebsocketSession("ws" + strings.TrimPrefix(server.URL, "http"))
+	session.MinReconnectDelay = 10 * time.Millisecond
+	session.MaxReconnectDelay = 50 * time.Millisecond
+	session.Handshake = func(ctx context.Context, conn *WebsocketConn) error {
+		return conn.WriteJSON("hello")
+	}
+	return session
+}
+
+// awaitMessage waits for the server to receive want.
+func awaitMessage(t *testing.T, messages <-chan string, want string) {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case message := <-messages:
+			if message == want {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for %s", want)
+		}
+	}
+}
+
+// awaitEvent waits for the next session event.
+func awaitEvent(t *testing.T, session *WebsocketSession) SessionEvent {
+	t.Helper()
+	select {
+	case event := <-session.Events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for session event")
+		return SessionEvent{}
+	}
+}
+
+func TestWebsocketSessionReconnects(t *testing.T) {
+	server, messages := newSessionServer(t, func(n int64, conn *websocket.Conn) {
+		if n == 1 {
+			// Drop the first connection once the handshake and the subscription arrived.
+			conn.ReadMessage()
+			conn.ReadMessage()
+			conn.Close()
+		}
+	})
+	defer server.Close()
+
+	session := newTestSession(server)
+	var authentications atomic.Int64
+	session.Authenticate = func(ctx context.Context) (string, error) {
+		authentications.Add(1)
+		return "", nil
+	}
+
+	if err := session.Subscribe(context.Background(), "quotes", func(ctx context.Context, conn *WebsocketConn) error {
+		return conn.WriteJSON("subscribe")
+	}); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer session.Close()
+
+	if event := awaitEvent(t, session); event.Type != SessionConnected {
+		t.Errorf("expected %s, got %s", SessionConnected, event.Type)
+	}
+	if event := awaitEvent(t, session); event.Type != SessionDisconnected || event.Err == nil {
+		t.Errorf("unexpected event %+v", event)
+	}
+	event := awaitEvent(t, session)
+	if event.Type != SessionReconnected {
+		t.Errorf("expected %s, got %s", SessionReconnected, event.Type)
+	}
+	if event.Attempt != 1 {
+		t.Errorf("expected %d, got %d", 1, event.Attempt)
+	}
+
+	awaitMessage(t, messages, `2:"hello"`)
+	awaitMessage(t, messages, `2:"subscribe"`)
+
+	if authentications.Load() != 2 {
+		t.Errorf("expected %d, got %d", 2, authentications.Load())
+	}
+
+	if err := session.Send("ping"); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+	awaitMessage(t, messages, `2:"ping"`)
+}
+
+func TestWebsocketSessionMissedKeepalive(t *testing.T) {
+	// The server never writes, so the session must notice the silence and reconnect.
+	server, messages := newSessionServer(t, nil)
+	defer server.Close()
+
+	session := newTestSession(server)
+	session.ReadTimeout = 50 * time.Millisecond
+
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer session.Close()
+
+	awaitEvent(t, session)
+	event := awaitEvent(t, session)
+	if event.Type != SessionDisconnected {
+		t.Fatalf("expected %s, got %s", SessionDisconnected, event.Type)
+	}
+	var netErr interface{ Timeout() bool }
+	if !errors.As(event.Err, &netErr) || !netErr.Timeout() {
+		t.Errorf("expected timeout error, got %v", event.Err)
+	}
+	if event := awaitEvent(t, session); event.Type != SessionReconnected {
+		t.Errorf("expected %s, got %s", SessionReconnected, event.Type)
+	}
+	awaitMessage(t, messages, `2:"hello"`)
+}
+
+func TestWebsocketSessionKeepalive(t *testing.T) {
+	server, messages := newSessionServer(t, nil)
+	defer server.Close()
+
+	session := newTestSession(server)
+	session.KeepaliveInterval = 10 * time.Millisecond
+	session.Keepalive = func(ctx context.Context, conn *WebsocketConn) error {
+		return conn.WriteJSON("keepalive")
+	}
+
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer session.Close()
+
+	awaitMessage(t, messages, `1:"keepalive"`)
+}
+
+func TestWebsocketSessionGivesUp(t *testing.T) {
+	server, _ := newSessionServer(t, func(n int64, conn *websocket.Conn) {
+		conn.Close()
+	})
+	defer server.Close()
+
+	session := newTestSession(server)
+	session.MaxReconnectAttempts = 2
+	var handshakes atomic.Int64
+	session.Handshake = func(ctx context.Context, conn *WebsocketConn) error {
+		if handshakes.Add(1) > 1 {
+			return errors.New("server unavailable")
+		}
+		return nil
+	}
+
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for session to stop")
+	}
+
+	if err := session.Err(); err == nil || !strings.Contains(err.Error(), "server unavailable") {
+		t.Errorf("expected reconnect error, got %v", err)
+	}
+
+	if err := session.Send("ping"); err != ErrSessionClosed {
+		t.Errorf("expected %v, got %v", ErrSessionClosed, err)
+	}
+}
+
+func TestWebsocketSessionHandshakeTimeout(t *testing.T) {
+	// The server accepts every connection but never answers the handshake.
+	server, _ := newSessionServer(t, nil)
+	defer server.Close()
+
+	session := newTestSession(server)
+	session.HandshakeTimeout = 50 * time.Millisecond
+	session.Handshake = func(ctx context.Context, conn *WebsocketConn) error {
+		if err := conn.WriteJSON("hello"); err != nil {
+			return err
+		}
+		var ack string
+		return conn.ReadJSON(&ack)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- session.Start(context.Background())
+	}()
+
+	select {
+	case err := <-errs:
+		var netErr interface{ Timeout() bool }
+		if !errors.As(err, &netErr) || !netErr.Timeout() {
+			t.Errorf("expected timeout error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for handshake to fail")
+	}
+}
+
+func TestWebsocketSessionReconnectHandshakeTimeout(t *testing.T) {
+	// The first connection is acked and dropped; reconnects are accepted but never acked.
+	server, _ := newSessionServer(t, func(n int64, conn *websocket.Conn) {
+		if n == 1 {
+			conn.ReadMessage()
+			conn.WriteJSON("ack")
+			conn.Close()
+		}
+	})
+	defer server.Close()
+
+	session := newTestSession(server)
+	session.HandshakeTimeout = 50 * time.Millisecond
+	session.MaxReconnectAttempts = 2
+	session.Handshake = func(ctx context.Context, conn *WebsocketConn) error {
+		if err := conn.WriteJSON("hello"); err != nil {
+			return err
+		}
+		var ack string
+		return conn.ReadJSON(&ack)
+	}
+
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer session.Close()
+
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for session to give up")
+	}
+
+	var netErr interface{ Timeout() bool }
+	if err := session.Err(); !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+}
+
+func TestWebsocketSessionKeepsReconnectEvents(t *testing.T) {
+	// Drop the first 10 connections right after the handshake while nobody reads Events,
+	// which overflows its buffer.
+	const drops = 10
+	server, messages := newSessionServer(t, func(n int64, conn *websocket.Conn) {
+		if n <= drops {
+			conn.ReadMessage()
+			conn.Close()
+		}
+	})
+	defer server.Close()
+
+	session := newTestSession(server)
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer session.Close()
+
+	awaitMessage(t, messages, fmt.Sprintf(`%d:"hello"`, drops+1))
+
+	attempts := 0
+	for attempts < drops {
+		if event := awaitEvent(t, session); event.Type == SessionReconnected {
+			attempts += event.Attempt
+		}
+	}
+	if attempts != drops {
+		t.Errorf("expected %d, got %d", drops, attempts)
+	}
+}
+
+func TestWebsocketSessionSendCtx(t *testing.T) {
+	server, messages := newSessionServer(t, nil)
+	defer server.Close()
+
+	session := newTestSession(server)
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer session.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := session.SendCtx(ctx, "ping"); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+	awaitMessage(t, messages, `1:"ping"`)
+
+	cancel()
+	if err := session.SendCtx(ctx, "pong"); err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestWebsocketSessionWriteTimeout(t *testing.T) {
+	// The server never reads, so writes block once the socket buffers are full.
+	release := make(chan struct{})
+	server, _ := newSessionServer(t, func(n int64, conn *websocket.Conn) {
+		<-release
+	})
+	defer server.Close()
+	defer close(release)
+
+	session := newTestSession(server)
+	session.WriteTimeout = 100 * time.Millisecond
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	defer session.Close()
+
+	payload := strings.Repeat("x", 1<<20)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			if err := session.Send(payload); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	select {
+	case err := <-errs:
+		var netErr interface{ Timeout() bool }
+		if !errors.As(err, &netErr) || !netErr.Timeout() {
+			t.Errorf("expected timeout error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for write to fail")
+	}
+}
+
+func TestWebsocketSessionCloseWithStuckWriter(t *testing.T) {
+	release := make(chan struct{})
+	server, _ := newSessionServer(t, func(n int64, conn *websocket.Conn) {
+		<-release
+	})
+	defer server.Close()
+	defer close(release)
+
+	session := newTestSession(server)
+	session.WriteTimeout = 0
+	if err := session.Start(context.Background()); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	payload := strings.Repeat("x", 1<<20)
+	sending := make(chan struct{})
+	go func() {
+		close(sending)
+		for session.Send(payload) == nil {
+		}
+	}()
+	<-sending
+	// Give the writer time to fill the socket buffers and block.
+	time.Sleep(200 * time.Millisecond)
+
+	closed := make(chan struct{})
+	go func() {
+		session.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Close")
+	}
+}